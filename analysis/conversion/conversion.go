@@ -0,0 +1,92 @@
+// Package conversion defines an analyzer reporting conversions of non-constant values into enum types
+// generated by go-enum-codegen, which bypass the validation of the generated readers.
+package conversion
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/ejfrick/go-enum-codegen/analysis/enums"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for unchecked conversions into go-enum-codegen enums
+
+A conversion such as Color(input) of a value that is not a constant into an enum
+type generated by go-enum-codegen can produce a value outside of the constants of
+the type. Parse the input with enum.Parse or a generated reader instead, or check
+the result with IsValid.
+
+Conversions in generated files are ignored. A conversion known to be safe can be
+marked with an "enum:unchecked" comment on the same line or the line above.`
+
+// Suppression is the comment marking a conversion as intentional.
+const Suppression = "enum:unchecked"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "enumconversion",
+	Doc:      Doc,
+	Run:      run,
+	Requires: []*analysis.Analyzer{enums.Analyzer},
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	known := pass.ResultOf[enums.Analyzer].(enums.Result)
+
+	for _, file := range pass.Files {
+		if ast.IsGenerated(file) {
+			continue
+		}
+		suppressed := suppressedLines(pass.Fset, file)
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+			fun, ok := pass.TypesInfo.Types[call.Fun]
+			if !ok || !fun.IsType() {
+				return true
+			}
+			named, _, ok := known.Lookup(fun.Type)
+			if !ok {
+				return true
+			}
+			arg, ok := pass.TypesInfo.Types[call.Args[0]]
+			if !ok || arg.Value != nil || types.Identical(arg.Type, named) {
+				return true
+			}
+			line := pass.Fset.Position(call.Pos()).Line
+			if suppressed[line] || suppressed[line-1] {
+				return true
+			}
+			pass.Reportf(call.Pos(), "unchecked conversion of %s to enum type %s: %s", types.TypeString(arg.Type, types.RelativeTo(pass.Pkg)), named.Obj().Name(), suggestion(named))
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// suppressedLines returns the lines of file holding a comment containing Suppression.
+func suppressedLines(fset *token.FileSet, file *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			if strings.Contains(c.Text, Suppression) {
+				lines[fset.Position(c.Pos()).Line] = true
+			}
+		}
+	}
+	return lines
+}
+
+// suggestion names the checked alternative to a conversion into named.
+func suggestion(named *types.Named) string {
+	name := named.Obj().Name()
+	if obj, _, _ := types.LookupFieldOrMethod(named, false, named.Obj().Pkg(), "IsValid"); obj != nil {
+		return "use enum.Parse[" + name + "] or check the result with " + name + ".IsValid"
+	}
+	return "parse the input with the generated UnmarshalJSON or Scan method instead"
+}
@@ -0,0 +1,12 @@
+package conversion_test
+
+import (
+	"testing"
+
+	"github.com/ejfrick/go-enum-codegen/analysis/conversion"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), conversion.Analyzer, "a", "b")
+}
@@ -0,0 +1,32 @@
+package a
+
+type Color int
+
+const (
+	ColorRed Color = iota
+	ColorGreen
+	ColorBlue
+)
+
+type Size string
+
+const (
+	SizeSmall Size = "small"
+	SizeLarge Size = "large"
+)
+
+type Plain int
+
+func convert(i int, s string, c Color) {
+	_ = Color(i) // want `unchecked conversion of int to enum type Color: use enum.Parse\[Color\] or check the result with Color.IsValid`
+	_ = Size(s)  // want `unchecked conversion of string to enum type Size: parse the input with the generated UnmarshalJSON or Scan method instead`
+	_ = Color(2)
+	_ = Size("small")
+	_ = Color(c)
+	_ = Plain(i)
+
+	_ = Color(i) // enum:unchecked
+
+	// enum:unchecked the index is bounded by the loop
+	_ = Color(i)
+}
@@ -0,0 +1,7 @@
+package b
+
+import "a"
+
+func pick(i int) a.Color {
+	return a.Color(i + 1) // want `unchecked conversion of int to enum type Color`
+}
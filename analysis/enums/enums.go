@@ -0,0 +1,137 @@
+// Package enums finds the enum types generated by go-enum-codegen, for use by other analyzers.
+package enums
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	goenumcodegen "github.com/ejfrick/go-enum-codegen"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Fact marks a type with methods generated by go-enum-codegen and records its constants.
+type Fact struct {
+	// Constants holds every constant of the type in declaration order.
+	Constants []Constant
+}
+
+// Constant is a constant of an enum type.
+type Constant struct {
+	Name string
+	// Value is the exact value of the constant, as returned by constant.Value.ExactString.
+	Value string
+}
+
+func (*Fact) AFact() {}
+
+func (f *Fact) String() string {
+	names := make([]string, len(f.Constants))
+	for i, c := range f.Constants {
+		names[i] = c.Name
+	}
+	return fmt.Sprintf("enum(%s)", strings.Join(names, ", "))
+}
+
+// Result maps every enum type declared in or imported by the package to its fact.
+type Result map[*types.TypeName]*Fact
+
+var Analyzer = &analysis.Analyzer{
+	Name:       "enums",
+	Doc:        "find the enum types generated by go-enum-codegen",
+	Run:        run,
+	FactTypes:  []analysis.Fact{new(Fact)},
+	ResultType: reflect.TypeOf(Result(nil)),
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		if !isGenerated(file) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+				continue
+			}
+			named, ok := receiverType(pass.TypesInfo.TypeOf(fn.Recv.List[0].Type))
+			if !ok || named.Obj().Pkg() != pass.Pkg {
+				continue
+			}
+			if pass.ImportObjectFact(named.Obj(), new(Fact)) {
+				continue
+			}
+			if fact := collect(pass.Pkg, named); fact != nil {
+				pass.ExportObjectFact(named.Obj(), fact)
+			}
+		}
+	}
+
+	result := make(Result)
+	for _, f := range pass.AllObjectFacts() {
+		typeName, ok := f.Object.(*types.TypeName)
+		if !ok {
+			continue
+		}
+		if fact, ok := f.Fact.(*Fact); ok {
+			result[typeName] = fact
+		}
+	}
+
+	return result, nil
+}
+
+// Lookup returns the fact of the enum type of t, if t is one.
+func (r Result) Lookup(t types.Type) (*types.Named, *Fact, bool) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return nil, nil, false
+	}
+	fact, ok := r[named.Obj()]
+	return named, fact, ok
+}
+
+func isGenerated(file *ast.File) bool {
+	return len(file.Comments) > 0 && strings.HasPrefix(file.Comments[0].List[0].Text, goenumcodegen.GeneratedPrefix)
+}
+
+func receiverType(t types.Type) (*types.Named, bool) {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	return named, ok
+}
+
+// collect returns the fact of named, or nil if it is not a basic type with constants, such as a generated Null<Type> struct.
+func collect(pkg *types.Package, named *types.Named) *Fact {
+	if _, ok := named.Underlying().(*types.Basic); !ok {
+		return nil
+	}
+
+	var consts []*types.Const
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if ok && types.Identical(c.Type(), named) {
+			consts = append(consts, c)
+		}
+	}
+	if len(consts) == 0 {
+		return nil
+	}
+	// scope.Names is sorted by name; report constants in declaration order
+	slices.SortFunc(consts, func(a, b *types.Const) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+
+	fact := &Fact{Constants: make([]Constant, len(consts))}
+	for i, c := range consts {
+		fact.Constants[i] = Constant{Name: c.Name(), Value: c.Val().ExactString()}
+	}
+	return fact
+}
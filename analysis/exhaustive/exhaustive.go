@@ -0,0 +1,69 @@
+// Package exhaustive defines an analyzer reporting switch statements over enum types generated by go-enum-codegen
+// that neither cover every constant nor have a default case.
+package exhaustive
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/ejfrick/go-enum-codegen/analysis/enums"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check that switch statements over go-enum-codegen enums are exhaustive
+
+A switch statement whose tag is an enum type generated by go-enum-codegen must
+list every constant of the type in its cases, or have a default case.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "enumexhaustive",
+	Doc:      Doc,
+	Run:      run,
+	Requires: []*analysis.Analyzer{enums.Analyzer, inspect.Analyzer},
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	known := pass.ResultOf[enums.Analyzer].(enums.Result)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	inspect.Preorder([]ast.Node{(*ast.SwitchStmt)(nil)}, func(n ast.Node) {
+		stmt := n.(*ast.SwitchStmt)
+		if stmt.Tag == nil {
+			return
+		}
+		named, fact, ok := known.Lookup(pass.TypesInfo.TypeOf(stmt.Tag))
+		if !ok {
+			return
+		}
+
+		covered := make(map[string]bool)
+		for _, clause := range stmt.Body.List {
+			cc := clause.(*ast.CaseClause)
+			if cc.List == nil {
+				// default case
+				return
+			}
+			for _, expr := range cc.List {
+				if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+					covered[tv.Value.ExactString()] = true
+				}
+			}
+		}
+
+		var missing []string
+		for _, c := range fact.Constants {
+			if !covered[c.Value] {
+				missing = append(missing, c.Name)
+				// aliases share a value and need only be listed once
+				covered[c.Value] = true
+			}
+		}
+		if len(missing) > 0 {
+			pass.Reportf(stmt.Pos(), "missing cases in switch of type %s: %s", named.Obj().Name(), strings.Join(missing, ", "))
+		}
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,36 @@
+package a
+
+type Color int
+
+const (
+	ColorRed Color = iota
+	ColorGreen
+	ColorBlue
+	ColorCrimson = ColorRed
+)
+
+type Plain int
+
+const (
+	PlainOne Plain = iota
+	PlainTwo
+)
+
+func describe(c Color, p Plain) {
+	switch c { // want "missing cases in switch of type Color: ColorBlue"
+	case ColorRed, ColorGreen:
+	}
+
+	switch c {
+	case ColorCrimson, ColorGreen, ColorBlue:
+	}
+
+	switch c {
+	case ColorRed:
+	default:
+	}
+
+	switch p {
+	case PlainOne:
+	}
+}
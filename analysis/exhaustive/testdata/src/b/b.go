@@ -0,0 +1,11 @@
+package b
+
+import "a"
+
+func paint(c a.Color) string {
+	switch c { // want "missing cases in switch of type Color: ColorGreen, ColorBlue"
+	case a.ColorRed:
+		return "red"
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+package goenumcodegen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// writeProtoBridge renders ProtoBridgeTemplate for typeName and the protobuf enum protoType, given as "importpath.TypeName".
+func (g *Generator) writeProtoBridge(values []Value, typeName string, protoType string) error {
+	dot := strings.LastIndex(protoType, ".")
+	if dot <= 0 || dot == len(protoType)-1 {
+		return fmt.Errorf("invalid proto bridge %q: expected importpath.TypeName", protoType)
+	}
+	importPath, protoTypeName := protoType[:dot], protoType[dot+1:]
+
+	pkg, err := g.loadProtoPackage(importPath)
+	if err != nil {
+		return fmt.Errorf("error loading proto package %s: %w", importPath, err)
+	}
+	protoValues, _ := g.collectValues(pkg, protoTypeName)
+	if len(protoValues) == 0 {
+		return fmt.Errorf("no values defined for proto type %s", protoType)
+	}
+	g.logf("detected %d values for proto type %s", len(protoValues), protoType)
+
+	pairs, err := MatchProtoValues(typeName, values, protoTypeName, protoValues)
+	if err != nil {
+		return err
+	}
+
+	data := g.templateData()
+	data.Proto = &ProtoData{
+		ImportPath: importPath,
+		Package:    pkg.name,
+		TypeName:   protoTypeName,
+	}
+	for _, pair := range pairs {
+		data.Proto.Pairs = append(data.Proto.Pairs, ProtoPair{Value: pair[0], Proto: pair[1]})
+	}
+	return g.writeTemplate(ProtoBridgeTemplate, data)
+}
+
+func (g *Generator) loadProtoPackage(importPath string) (*Package, error) {
+	if pkg, ok := g.protoPkgs[importPath]; ok {
+		return pkg, nil
+	}
+	pkg, err := g.loadPackage([]string{importPath})
+	if err != nil {
+		return nil, err
+	}
+	if g.protoPkgs == nil {
+		g.protoPkgs = make(map[string]*Package)
+	}
+	g.protoPkgs[importPath] = pkg
+
+	return pkg, nil
+}
+
+// MatchProtoValues pairs each value of typeName with the protoc-gen-go constant of protoTypeName
+// that has the same normalized name. Every value on either side must have exactly one counterpart.
+func MatchProtoValues(typeName string, values []Value, protoTypeName string, protoValues []Value) ([][2]Value, error) {
+	byName := make(map[string]Value, len(protoValues))
+	for _, pv := range protoValues {
+		key := NormalizeProtoEnumName(protoTypeName, pv.Name)
+		if other, ok := byName[key]; ok && other.StrVal != pv.StrVal {
+			return nil, fmt.Errorf("proto constants %s and %s both normalize to %q", other.Name, pv.Name, key)
+		}
+		byName[key] = pv
+	}
+
+	var pairs [][2]Value
+	var missing []string
+	matched := make(map[string]bool, len(byName))
+	for _, v := range values {
+		key := NormalizeEnumName(typeName, v.Name)
+		if matched[key] {
+			return nil, fmt.Errorf("more than one constant of %s normalizes to %q", typeName, key)
+		}
+		pv, ok := byName[key]
+		if !ok {
+			missing = append(missing, v.Name)
+			continue
+		}
+		matched[key] = true
+		pairs = append(pairs, [2]Value{v, pv})
+	}
+	for key, pv := range byName {
+		if !matched[key] {
+			missing = append(missing, pv.Name)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return nil, fmt.Errorf("constants without a counterpart between %s and %s: %s", typeName, protoTypeName, strings.Join(missing, ", "))
+	}
+
+	return pairs, nil
+}
+
+// NormalizeEnumName strips the type name prefix from a Go constant name and
+// lower-cases the rest, e.g. StatusInProgress -> inprogress.
+func NormalizeEnumName(typeName string, name string) string {
+	return normalizeName(strings.TrimPrefix(name, typeName))
+}
+
+// NormalizeProtoEnumName strips the prefixes protoc-gen-go and the protobuf style guide
+// put in front of enum value names and lower-cases the rest,
+// e.g. Status_STATUS_IN_PROGRESS -> inprogress.
+func NormalizeProtoEnumName(protoTypeName string, name string) string {
+	// nested enums are prefixed with the parent message name rather than their own
+	parent, enumName := protoTypeName, protoTypeName
+	if i := strings.LastIndex(protoTypeName, "_"); i >= 0 {
+		parent, enumName = protoTypeName[:i], protoTypeName[i+1:]
+	}
+	name = strings.TrimPrefix(name, parent+"_")
+	name = strings.TrimPrefix(name, upperSnake(enumName)+"_")
+	return normalizeName(name)
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func upperSnake(name string) string {
+	var s strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			_, _ = s.WriteRune('_')
+		}
+		_, _ = s.WriteRune(unicode.ToUpper(r))
+	}
+	return s.String()
+}
@@ -0,0 +1,74 @@
+package goenumcodegen
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNormalizeProtoEnumName(t *testing.T) {
+	tt := []struct {
+		Name      string
+		ProtoType string
+		Input     string
+		Expected  string
+	}{
+		{
+			Name:      "top-level enum with style guide prefix",
+			ProtoType: "Status",
+			Input:     "Status_STATUS_IN_PROGRESS",
+			Expected:  "inprogress",
+		},
+		{
+			Name:      "top-level enum without style guide prefix",
+			ProtoType: "Status",
+			Input:     "Status_IN_PROGRESS",
+			Expected:  "inprogress",
+		},
+		{
+			Name:      "multi-word enum name",
+			ProtoType: "OrderStatus",
+			Input:     "OrderStatus_ORDER_STATUS_SHIPPED",
+			Expected:  "shipped",
+		},
+		{
+			Name:      "nested enum",
+			ProtoType: "Order_Status",
+			Input:     "Order_STATUS_SHIPPED",
+			Expected:  "shipped",
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := NormalizeProtoEnumName(tc.ProtoType, tc.Input)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestMatchProtoValues(t *testing.T) {
+	protoValues := []Value{
+		{Name: "Status_STATUS_UNSPECIFIED", StrVal: "0"},
+		{Name: "Status_STATUS_ACTIVE", StrVal: "1"},
+	}
+
+	t.Run("all values matched", func(t *testing.T) {
+		values := []Value{
+			{Name: "StatusUnspecified", StrVal: "0"},
+			{Name: "StatusActive", StrVal: "1"},
+		}
+		pairs, err := MatchProtoValues("Status", values, "Status", protoValues)
+		assert.NoError(t, err)
+		assert.Equal(t, [][2]Value{{values[0], protoValues[0]}, {values[1], protoValues[1]}}, pairs)
+	})
+
+	t.Run("value missing on one side", func(t *testing.T) {
+		values := []Value{
+			{Name: "StatusActive", StrVal: "1"},
+			{Name: "StatusDone", StrVal: "2"},
+		}
+		_, err := MatchProtoValues("Status", values, "Status", protoValues)
+		assert.EqualError(t, err, "constants without a counterpart between Status and Status: StatusDone, Status_STATUS_UNSPECIFIED")
+	})
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHeaderArgs(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Input    string
+		Expected []string
+		Ok       bool
+	}{
+		{
+			Name:     "generated file",
+			Input:    "// Code generated by \"go-enum-codegen -type MyEnum -tests\"; DO NOT EDIT.\n",
+			Expected: []string{"-type", "MyEnum", "-tests"},
+			Ok:       true,
+		},
+		{
+			Name:     "windows line ending",
+			Input:    "// Code generated by \"go-enum-codegen -type MyEnum\"; DO NOT EDIT.\r\n",
+			Expected: []string{"-type", "MyEnum"},
+			Ok:       true,
+		},
+		{
+			Name:     "quoted arguments",
+			Input:    "// Code generated by \"go-enum-codegen -type MyEnum -template-dir \"my templates\" -default \"\"\"; DO NOT EDIT.\n",
+			Expected: []string{"-type", "MyEnum", "-template-dir", "my templates", "-default", ""},
+			Ok:       true,
+		},
+		{
+			Name:  "unterminated quote",
+			Input: "// Code generated by \"go-enum-codegen -type \"MyEnum\"; DO NOT EDIT.\n",
+		},
+		{
+			Name:  "other generator",
+			Input: "// Code generated by \"stringer -type MyEnum\"; DO NOT EDIT.\n",
+		},
+		{
+			Name:  "hand-written file",
+			Input: "package myenum\n",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, ok := headerArgs(tc.Input)
+			assert.Equal(t, tc.Ok, ok)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestCheckFile(t *testing.T) {
+	tt := []struct {
+		Name     string
+		File     string
+		Args     []string
+		Expected bool
+	}{
+		{
+			Name:     "up to date",
+			File:     "testdata/check/fresh/myenum.gen.go",
+			Args:     []string{"-type", "MyEnum"},
+			Expected: true,
+		},
+		{
+			Name:     "quoted arguments",
+			File:     "testdata/check/quoted/myenum.gen.go",
+			Args:     []string{"-type", "MyEnum", "-template-dir", "my templates"},
+			Expected: true,
+		},
+		{
+			Name: "constant added since generation",
+			File: "testdata/check/stale/myenum.gen.go",
+			Args: []string{"-type", "MyEnum"},
+		},
+		{
+			Name: "generated test file removed",
+			File: "testdata/check/missing/myenum.gen.go",
+			Args: []string{"-type", "MyEnum", "-tests"},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			args, ok, err := readHeaderArgs(tc.File)
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, tc.Args, args)
+			assert.Equal(t, tc.Expected, checkFile(tc.File, args))
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const generateDirective = "//go:generate"
+
+// flagAliases maps short flags to the flag they are the same as.
+var flagAliases = map[string]string{
+	"e": "error-on-unknown",
+	"h": "help",
+}
+
+// impliedFlags maps flags to a flag they imply, which is redundant next to them.
+var impliedFlags = map[string]string{
+	"pflag":     "flag",
+	"ts-guards": "ts",
+}
+
+// flagRewrite migrates a flag that defineFlags no longer defines.
+type flagRewrite struct {
+	// isBool is true if the flag took no value
+	isBool bool
+	// rewrite returns the arguments replacing the flag given its value, "true" for a bare boolean flag
+	rewrite func(value string) []string
+}
+
+// deprecatedFlags maps renamed or removed flags to their rewrite. A plain invocation rejects them
+// as unknown flags, so an entry is added here whenever a flag leaves defineFlags.
+var deprecatedFlags = map[string]flagRewrite{}
+
+// runFix implements "go-enum-codegen fix", rewriting the arguments of every go:generate directive
+// invoking go-enum-codegen in the .go files under the given directories to their canonical form.
+func runFix(args []string) {
+	fixFlags := flag.NewFlagSet("go-enum-codegen fix", flag.ExitOnError)
+	write := fixFlags.Bool("w", false, "write the rewritten directives to the source files instead of printing them")
+	fixFlags.Usage = func() {
+		fmt.Fprintf(fixFlags.Output(), "Usage: go-enum-codegen fix [-w] [dir ...]\n\n")
+		fmt.Fprintf(fixFlags.Output(), "Rewrites go:generate directives invoking go-enum-codegen to their canonical form, default the current directory.\n")
+		fixFlags.PrintDefaults()
+	}
+	_ = fixFlags.Parse(args)
+
+	roots := fixFlags.Args()
+	if len(roots) == 0 {
+		roots = []string{"."}
+	}
+
+	failed := false
+	for _, root := range roots {
+		// directories are always walked recursively, so accept package patterns too
+		root = strings.TrimSuffix(root, "/...")
+		if root == "..." {
+			root = "."
+		}
+		err := filepath.WalkDir(root, func(name string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if name != root && skipDir(d.Name()) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(name, ".go") {
+				return nil
+			}
+			if !fixFile(name, *write) {
+				failed = true
+			}
+			return nil
+		})
+		if err != nil {
+			errExitf("error walking %s: %v", root, err)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// skipDir reports whether a directory is ignored by the go tool.
+func skipDir(name string) bool {
+	return name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+// fixFile rewrites the go-enum-codegen directives of a file, or prints them if write is false.
+// It returns false if a directive could not be parsed.
+func fixFile(name string, write bool) bool {
+	src, err := os.ReadFile(name)
+	if err != nil {
+		errExitf("failed to read %s: %v", name, err)
+	}
+
+	ok := true
+	changed := false
+	lines := bytes.SplitAfter(src, []byte("\n"))
+	for i, line := range lines {
+		text := string(line)
+		directive := strings.TrimRight(text, "\r\n")
+		if !strings.HasPrefix(directive, generateDirective+" ") {
+			continue
+		}
+		fixed, err := fixDirective(directive)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s:%d: %v\n", name, i+1, err)
+			ok = false
+			continue
+		}
+		if fixed == directive {
+			continue
+		}
+		changed = true
+		lines[i] = []byte(fixed + text[len(directive):])
+		if !write {
+			fmt.Printf("%s:%d: %s\n", name, i+1, fixed)
+		}
+	}
+
+	if changed && write {
+		info, err := os.Stat(name)
+		if err != nil {
+			errExitf("failed to stat %s: %v", name, err)
+		}
+		err = os.WriteFile(name, bytes.Join(lines, nil), info.Mode().Perm())
+		if err != nil {
+			errExitf("failed to write %s: %v", name, err)
+		}
+	}
+	return ok
+}
+
+// fixDirective returns a go:generate directive with the go-enum-codegen arguments in canonical form:
+// -type first, then the other flags in alphabetical order, without aliases, deprecated flags, flags set
+// to their default, or flags implied by another one, followed by the positional arguments.
+// Everything up to and including the command word is kept as written.
+// Directives that do not invoke go-enum-codegen are returned unchanged.
+func fixDirective(directive string) (string, error) {
+	args, end, ok, err := codegenArgs(directive)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return directive, nil
+	}
+	canonical, err := canonicalArgs(args)
+	if err != nil {
+		return "", err
+	}
+
+	return directive[:end] + formatArgs(canonical), nil
+}
+
+// codegenArgs returns the arguments of a go:generate directive invoking go-enum-codegen
+// and the offset just past the command word, or false if the directive runs another command.
+func codegenArgs(directive string) ([]string, int, bool, error) {
+	words, err := splitDirective(directive)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	for i, w := range words {
+		if !isCodegenCommand(w.text) {
+			continue
+		}
+		args := make([]string, 0, len(words)-i-1)
+		for _, arg := range words[i+1:] {
+			args = append(args, arg.text)
+		}
+		return args, w.end, true, nil
+	}
+	return nil, 0, false, nil
+}
+
+// formatArgs joins args into the tail of a directive, each preceded by a space.
+func formatArgs(args []string) string {
+	var s strings.Builder
+	for _, arg := range args {
+		_, _ = s.WriteString(" " + quoteArg(arg))
+	}
+	return s.String()
+}
+
+// canonicalArgs parses args with the flags of a plain invocation and returns them in canonical form.
+func canonicalArgs(args []string) ([]string, error) {
+	flags := flag.NewFlagSet("go-enum-codegen", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	defineFlags(flags)
+	if err := flags.Parse(migrateFlags(flags, args)); err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]string)
+	flags.Visit(func(f *flag.Flag) {
+		name := f.Name
+		if alias, ok := flagAliases[name]; ok {
+			name = alias
+		}
+		set[name] = f.Value.String()
+	})
+	for name, implied := range impliedFlags {
+		if set[name] == "true" {
+			delete(set, implied)
+		}
+	}
+
+	var canonical []string
+	if typeNames, ok := set["type"]; ok {
+		canonical = append(canonical, "-type", typeNames)
+	}
+	flags.VisitAll(func(f *flag.Flag) {
+		value, ok := set[f.Name]
+		if !ok || f.Name == "type" {
+			return
+		}
+		if isBoolFlag(f) {
+			if value == "true" {
+				canonical = append(canonical, "-"+f.Name)
+			}
+			return
+		}
+		if value != "" {
+			canonical = append(canonical, "-"+f.Name, value)
+		}
+	})
+	positional := flags.Args()
+	if len(positional) > 0 && strings.HasPrefix(positional[0], "-") {
+		// keep positional arguments from being read as flags
+		canonical = append(canonical, "--")
+	}
+	return append(canonical, positional...), nil
+}
+
+// migrateFlags returns args with every flag in deprecatedFlags replaced by its rewrite.
+// Like flag parsing, it stops at the first non-flag argument or "--".
+func migrateFlags(flags *flag.FlagSet, args []string) []string {
+	migrated := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" || len(arg) < 2 || arg[0] != '-' {
+			return append(migrated, args[i:]...)
+		}
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(arg[1:], "-"), "=")
+		if deprecated, ok := deprecatedFlags[name]; ok {
+			switch {
+			case hasValue:
+			case deprecated.isBool:
+				value = "true"
+			case i+1 < len(args):
+				i++
+				value = args[i]
+			}
+			migrated = append(migrated, deprecated.rewrite(value)...)
+			continue
+		}
+		migrated = append(migrated, arg)
+		// keep the value of a flag defined by defineFlags with it
+		if f := flags.Lookup(name); f != nil && !hasValue && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			migrated = append(migrated, args[i])
+		}
+	}
+	return migrated
+}
+
+// isBoolFlag reports whether f is set without a value.
+func isBoolFlag(f *flag.Flag) bool {
+	getter, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && getter.IsBoolFlag()
+}
+
+// isCodegenCommand reports whether a directive word runs go-enum-codegen,
+// either as a binary or as a package path given to go run.
+func isCodegenCommand(word string) bool {
+	word, _, _ = strings.Cut(word, "@")
+	return path.Base(word) == "go-enum-codegen"
+}
+
+type directiveWord struct {
+	text string
+	// end is the offset in the split text just past the word as written
+	end int
+}
+
+// splitDirective splits a go:generate directive into words like go generate does.
+func splitDirective(directive string) ([]directiveWord, error) {
+	words, err := splitWords(directive, len(generateDirective))
+	if err != nil {
+		return nil, fmt.Errorf("invalid go:generate directive: %w", err)
+	}
+	return words, nil
+}
+
+// splitWords splits s from offset start into words like go generate splits a directive:
+// words are separated by spaces and tabs, and a double-quoted word is a Go string literal.
+func splitWords(s string, start int) ([]directiveWord, error) {
+	var words []directiveWord
+	i := start
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+			i++
+		}
+		if i == len(s) {
+			return words, nil
+		}
+		wordStart := i
+		if s[i] == '"' {
+			for i++; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(s) {
+				return nil, errors.New("unterminated quoted string")
+			}
+			i++
+			text, err := strconv.Unquote(s[wordStart:i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted string: %w", err)
+			}
+			words = append(words, directiveWord{text: text, end: i})
+			continue
+		}
+		for i < len(s) && s[i] != ' ' && s[i] != '\t' {
+			i++
+		}
+		words = append(words, directiveWord{text: s[wordStart:i], end: i})
+	}
+}
+
+// quoteArg quotes an argument that go generate would otherwise split or unquote.
+func quoteArg(arg string) string {
+	if arg == "" || strings.ContainsAny(arg, " \t\"") {
+		return strconv.Quote(arg)
+	}
+	return arg
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFixDirective(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Err      bool
+	}{
+		{
+			Name:     "already canonical",
+			Input:    "//go:generate go-enum-codegen -type MyEnum -json",
+			Expected: "//go:generate go-enum-codegen -type MyEnum -json",
+		},
+		{
+			Name:     "type moved first and flags sorted",
+			Input:    "//go:generate go-enum-codegen -yaml -tests -type=MyEnum",
+			Expected: "//go:generate go-enum-codegen -type MyEnum -tests -yaml",
+		},
+		{
+			Name:     "aliases, defaults, and implied flags",
+			Input:    "//go:generate go-enum-codegen --type MyEnum -e -json=false -flag -pflag -default=",
+			Expected: "//go:generate go-enum-codegen -type MyEnum -error-on-unknown -pflag",
+		},
+		{
+			Name:     "go run prefix and positional arguments kept",
+			Input:    "//go:generate  go run github.com/ejfrick/go-enum-codegen/cmd/go-enum-codegen@v1.2.0 -runtime -type A,B $GOFILE",
+			Expected: "//go:generate  go run github.com/ejfrick/go-enum-codegen/cmd/go-enum-codegen@v1.2.0 -type A,B -runtime $GOFILE",
+		},
+		{
+			Name:     "quoted values",
+			Input:    `//go:generate go-enum-codegen "-type" MyEnum -tags "integration linux"`,
+			Expected: `//go:generate go-enum-codegen -type MyEnum -tags "integration linux"`,
+		},
+		{
+			Name:     "other command",
+			Input:    "//go:generate stringer -type MyEnum -e",
+			Expected: "//go:generate stringer -type MyEnum -e",
+		},
+		{
+			Name:  "unknown flag",
+			Input: "//go:generate go-enum-codegen -type MyEnum -no-such-flag",
+			Err:   true,
+		},
+		{
+			Name:  "unterminated quote",
+			Input: `//go:generate go-enum-codegen -type "MyEnum`,
+			Err:   true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := fixDirective(tc.Input)
+			if tc.Err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestFixDirectiveDeprecatedFlags(t *testing.T) {
+	// no flag has been removed yet, so register stand-ins for the test
+	saved := deprecatedFlags
+	t.Cleanup(func() { deprecatedFlags = saved })
+	deprecatedFlags = map[string]flagRewrite{
+		"json-only": {isBool: true, rewrite: func(value string) []string { return []string{"-json=" + value} }},
+		"out":       {rewrite: func(value string) []string { return []string{"-output", value} }},
+		"strict":    {isBool: true, rewrite: func(string) []string { return nil }},
+	}
+
+	tt := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "renamed boolean flag",
+			Input:    "//go:generate go-enum-codegen -type MyEnum -json-only",
+			Expected: "//go:generate go-enum-codegen -type MyEnum -json",
+		},
+		{
+			Name:     "renamed boolean flag set to false",
+			Input:    "//go:generate go-enum-codegen -type MyEnum --json-only=false",
+			Expected: "//go:generate go-enum-codegen -type MyEnum",
+		},
+		{
+			Name:     "renamed flag with a separate value",
+			Input:    "//go:generate go-enum-codegen -out my_enum.go -type MyEnum",
+			Expected: "//go:generate go-enum-codegen -type MyEnum -output my_enum.go",
+		},
+		{
+			Name:     "removed flag",
+			Input:    "//go:generate go-enum-codegen -strict -type MyEnum -yaml",
+			Expected: "//go:generate go-enum-codegen -type MyEnum -yaml",
+		},
+		{
+			Name:     "flag values and positional arguments are not migrated",
+			Input:    "//go:generate go-enum-codegen -type -out -yaml -- -strict",
+			Expected: "//go:generate go-enum-codegen -type -out -yaml -- -strict",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := fixDirective(tc.Input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	goenumcodegen "github.com/ejfrick/go-enum-codegen"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+)
+
+// output is a file written by a generate run.
+type output struct {
+	name string
+	src  []byte
+}
+
+// runGenerate implements "go-enum-codegen generate", which is also what a plain invocation does.
+func runGenerate(name string, args []string) {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	f := defineFlags(flags)
+	flags.Usage = func() {
+		usage(flags)
+	}
+	_ = flags.Parse(args)
+
+	if f.printUsage {
+		flags.Usage()
+		return
+	}
+
+	if f.printVersion {
+		buildInfo, ok := debug.ReadBuildInfo()
+		if !ok {
+			errExitf("error reading build info")
+		}
+		fmt.Println(buildInfo.Main.Path + "/cmd/go-enum-codegen")
+		version := buildInfo.Main.Version
+		if len(noVCSVersionOverride) > 0 {
+			version = noVCSVersionOverride
+		}
+		fmt.Println(version)
+		return
+	}
+
+	if f.dumpModel {
+		g, _, _ := generateTypes(f, ".", flags.Args())
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(g.Enums()); err != nil {
+			errExitf("failed to encode model: %v", err)
+		}
+		return
+	}
+
+	for _, out := range render(f, ".", args, flags.Args()) {
+		err := os.WriteFile(out.name, out.src, 0644)
+		if err != nil {
+			errExitf("failed to write %s: %v", out.name, err)
+		}
+	}
+}
+
+// usage prints the subcommands followed by the flags of generate.
+func usage(flags *flag.FlagSet) {
+	fmt.Fprintf(flags.Output(), `Usage of go-enum-codegen:
+  go-enum-codegen [generate] [flags] [directory | files]
+        generate code for the types listed with -type
+  go-enum-codegen check [dir ...]
+        fail if a file generated under the directories is out of date
+  go-enum-codegen list [-json] [-tags tags] [directory | files]
+        list the types that can be generated for and their constants
+  go-enum-codegen init [flags] -type T [directory]
+        add a go:generate directive for T with the given flags above its declaration
+  go-enum-codegen fix [-w] [dir ...]
+        rewrite go:generate directives under the directories to their canonical form
+Flags of generate and init:
+`)
+	flags.PrintDefaults()
+}
+
+// render runs the generator configured by f on the package given by patterns
+// and returns the files it writes. args are recorded in the header of generated Go files.
+// Relative paths in patterns and f are interpreted relative to workDir.
+func render(f *generateFlags, workDir string, args []string, patterns []string) []output {
+	g, typeList, dir := generateTypes(f, workDir, patterns)
+
+	g.WritePreambleAndImports(args)
+
+	src, err := g.Format()
+	if err != nil {
+		errExitf("error formatting code: %v", err)
+	}
+	outputName := resolvePath(workDir, f.output)
+	if outputName == "" {
+		baseName := fmt.Sprintf("%s.gen.go", typeList[0])
+		outputName = filepath.Join(dir, strings.ToLower(baseName))
+	}
+
+	outputs := []output{{name: outputName, src: src}}
+
+	if f.tests || f.bench {
+		testSrc, err := g.FormatTests(args)
+		if err != nil {
+			errExitf("error formatting tests: %v", err)
+		}
+		outputs = append(outputs, output{name: strings.TrimSuffix(outputName, ".go") + "_test.go", src: testSrc})
+	}
+	for _, typeName := range typeList {
+		if f.jsonSchema {
+			outputs = append(outputs, companionFile(dir, typeName, ".schema.json", g.JSONSchema))
+		}
+		if f.openAPI {
+			outputs = append(outputs, companionFile(dir, typeName, ".openapi.yaml", g.OpenAPISchema))
+		}
+		if f.typeScript || f.tsGuards {
+			outputs = append(outputs, companionFile(dir, typeName, ".ts", func(typeName string) ([]byte, error) {
+				return g.TypeScript(typeName, f.tsGuards)
+			}))
+		}
+		if f.sqlDDL != "" {
+			outputs = append(outputs, companionFile(dir, typeName, ".sql", func(typeName string) ([]byte, error) {
+				return g.SQLDDL(typeName, goenumcodegen.SQLDialect(f.sqlDDL))
+			}))
+		}
+	}
+
+	return outputs
+}
+
+// generateTypes runs the generator configured by f on every type listed with -type
+// and returns it along with the types and the directory of the package.
+// Relative paths in patterns and f are interpreted relative to workDir.
+func generateTypes(f *generateFlags, workDir string, patterns []string) (*goenumcodegen.Generator, []string, string) {
+	typeList := strings.Split(f.typeNames, ",")
+	if len(typeList) == 0 {
+		errExitf("no types specified")
+	}
+
+	tags := strings.Split(f.buildTags, ",")
+
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	for i, pattern := range patterns {
+		patterns[i] = resolvePath(workDir, pattern)
+	}
+
+	var dir string
+	if len(patterns) == 1 && isDirectory(patterns[0]) {
+		dir = patterns[0]
+	} else {
+		if len(tags) != 0 {
+			errExitf("-tags option applies only to directories, not individual files")
+		}
+		dir = filepath.Dir(patterns[0])
+	}
+
+	if f.jsonOnly && f.sqlOnly {
+		errExitf("`-only-json` and '-only-sql' are mutually exclusive")
+	}
+
+	switch goenumcodegen.SQLDialect(f.sqlDDL) {
+	case "", goenumcodegen.DialectPostgres, goenumcodegen.DialectMySQL, goenumcodegen.DialectSQLite:
+	default:
+		errExitf("-sql-ddl must be one of postgres, mysql, or sqlite")
+	}
+
+	var opts []goenumcodegen.Opt
+	if f.jsonOnly {
+		opts = append(opts, goenumcodegen.WithOnlyJsonMethods())
+	}
+	if f.sqlOnly {
+		opts = append(opts, goenumcodegen.WithOnlySQLMethods())
+	}
+	if f.yaml {
+		opts = append(opts, goenumcodegen.WithYamlMethods())
+	}
+	if f.flagMethods {
+		opts = append(opts, goenumcodegen.WithFlagMethods())
+	}
+	if f.pflagMethods {
+		opts = append(opts, goenumcodegen.WithPflagMethods())
+	}
+	if f.protoBridge != "" {
+		protoTypes := strings.Split(f.protoBridge, ",")
+		if len(protoTypes) > len(typeList) {
+			errExitf("-proto-bridge lists more proto types than -type lists types")
+		}
+		for i, protoType := range protoTypes {
+			if protoType != "" {
+				opts = append(opts, goenumcodegen.WithProtoBridge(typeList[i], protoType))
+			}
+		}
+	}
+	if f.defaults != "" {
+		defaults := strings.Split(f.defaults, ",")
+		if len(defaults) > len(typeList) {
+			errExitf("-default lists more constants than -type lists types")
+		}
+		for i, constName := range defaults {
+			if constName != "" {
+				opts = append(opts, goenumcodegen.WithDefault(typeList[i], constName))
+			}
+		}
+	}
+	if f.tests {
+		opts = append(opts, goenumcodegen.WithTests())
+	}
+	if f.bench {
+		opts = append(opts, goenumcodegen.WithBenchmarks())
+	}
+	if f.zeroAlloc {
+		opts = append(opts, goenumcodegen.WithZeroAllocJSON())
+	}
+	if f.describe {
+		opts = append(opts, goenumcodegen.WithDescriptions())
+	}
+	if f.null {
+		opts = append(opts, goenumcodegen.WithNullWrapper())
+	}
+	if f.runtime {
+		opts = append(opts, goenumcodegen.WithRuntime())
+	}
+	if f.typedErrors {
+		opts = append(opts, goenumcodegen.WithTypedErrors())
+	}
+	if f.errOnUnk {
+		opts = append(opts, goenumcodegen.WithErrorOnUnknown())
+	}
+	if f.useStringer {
+		opts = append(opts, goenumcodegen.WithUseStringer())
+	}
+	if f.checkStringer {
+		opts = append(opts, goenumcodegen.WithStringerCheck())
+	}
+	if f.templateDir != "" {
+		opts = append(opts, goenumcodegen.WithTemplateDir(resolvePath(workDir, f.templateDir)))
+	}
+	if f.debug {
+		opts = append(opts, goenumcodegen.WithDebug())
+	}
+
+	g := goenumcodegen.NewGenerator(opts...)
+
+	err := g.ParsePackage(patterns, tags)
+	if err != nil {
+		errExitf("error parsing package: %v", err)
+	}
+
+	for _, typeName := range typeList {
+		err := g.Generate(typeName)
+		if err != nil {
+			errExitf("error generating enum code for type %s: %v", typeName, err)
+		}
+	}
+
+	return g, typeList, dir
+}
+
+// resolvePath interprets a relative path name relative to workDir, keeping it prefixed with ./
+// so the go tool reads it as a directory rather than an import path.
+func resolvePath(workDir string, name string) string {
+	if workDir == "." || name == "" || filepath.IsAbs(name) {
+		return name
+	}
+	resolved := filepath.Join(workDir, name)
+	if filepath.IsAbs(resolved) || resolved == ".." || strings.HasPrefix(resolved, ".."+string(filepath.Separator)) {
+		return resolved
+	}
+	return "." + string(filepath.Separator) + resolved
+}
+
+func companionFile(dir string, typeName string, ext string, render func(string) ([]byte, error)) output {
+	src, err := render(typeName)
+	if err != nil {
+		errExitf("error generating %s file for type %s: %v", ext, typeName, err)
+	}
+	return output{name: filepath.Join(dir, strings.ToLower(typeName)+ext), src: src}
+}
+
+func isDirectory(name string) bool {
+	info, err := os.Stat(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return info.IsDir()
+}
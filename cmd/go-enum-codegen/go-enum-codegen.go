@@ -2,27 +2,43 @@ package main
 
 import (
 	"flag"
-	"fmt"
-	goenumcodegen "github.com/ejfrick/go-enum-codegen"
 	"log"
 	"os"
-	"path/filepath"
-	"runtime/debug"
-	"strings"
 )
 
-var (
-	flagTypeNames    string
-	flagOutput       string
-	flagErrOnUnk     bool
-	flagBuildTags    string
-	flagPrintUsage   bool
-	flagPrintVersion bool
-	flagJsonOnly     bool
-	flagSQLOnly      bool
-	flagUseStringer  bool
-	flagDebug        bool
-)
+// generateFlags holds the values of the flags of generate, as defined by defineFlags.
+type generateFlags struct {
+	typeNames     string
+	output        string
+	errOnUnk      bool
+	buildTags     string
+	printUsage    bool
+	printVersion  bool
+	jsonOnly      bool
+	sqlOnly       bool
+	yaml          bool
+	flagMethods   bool
+	pflagMethods  bool
+	jsonSchema    bool
+	openAPI       bool
+	protoBridge   string
+	defaults      string
+	sqlDDL        string
+	typeScript    bool
+	tsGuards      bool
+	tests         bool
+	bench         bool
+	zeroAlloc     bool
+	runtime       bool
+	null          bool
+	typedErrors   bool
+	describe      bool
+	useStringer   bool
+	checkStringer bool
+	dumpModel     bool
+	templateDir   string
+	debug         bool
+}
 
 func errExitf(format string, args ...any) {
 	log.Fatalf(format, args...)
@@ -33,120 +49,66 @@ var noVCSVersionOverride string
 func main() {
 	log.SetFlags(0)
 	log.SetPrefix("go-enum-codegen: ")
-	flag.StringVar(&flagTypeNames, "type", "", "comma-separated list of type names; must be set")
-	flag.StringVar(&flagOutput, "output", "", "output file name; default srcdir/<type>.gen.go")
-	flag.BoolVar(&flagErrOnUnk, "error-on-unknown", false, "whether to return an error if scanning or unmarshalling an unknown value; automatically set to true when iota is first set to \"_\" or there is no enum equal to the empty value of its underlying type; otherwise default is false and an unknown value will be assigned to the enum with the empty value of its underlying type")
-	flag.BoolVar(&flagErrOnUnk, "e", false, "same as -error-on-unknown")
-	flag.StringVar(&flagBuildTags, "tags", "", "comma-separated list of build tags to apply")
-	flag.BoolVar(&flagPrintUsage, "help", false, "show this help and exit")
-	flag.BoolVar(&flagPrintUsage, "h", false, "same as -help.")
-	flag.BoolVar(&flagPrintVersion, "version", false, "show version and exit")
-	flag.BoolVar(&flagJsonOnly, "json", false, "generate only json.Marshaler and json.Unmarshaler methods; default false")
-	flag.BoolVar(&flagSQLOnly, "sql", false, "generate only sql.Scanner and driver.Value methods; default false")
-	flag.BoolVar(&flagUseStringer, "stringer", false, "use the String() method of the enum instead of the underlying integer value; default false")
-	flag.BoolVar(&flagDebug, "debug", false, "output debug information about the tool")
-
-	flag.Parse()
-
-	if flagPrintUsage {
-		flag.Usage()
-		return
-	}
-
-	if flagPrintVersion {
-		buildInfo, ok := debug.ReadBuildInfo()
-		if !ok {
-			errExitf("error reading build info")
-		}
-		fmt.Println(buildInfo.Main.Path + "/cmd/go-enum-codegen")
-		version := buildInfo.Main.Version
-		if len(noVCSVersionOverride) > 0 {
-			version = noVCSVersionOverride
-		}
-		fmt.Println(version)
-		return
-	}
 
-	typeList := strings.Split(flagTypeNames, ",")
-	if len(typeList) == 0 {
-		flag.Usage()
-		errExitf("no types specified")
-	}
-
-	tags := strings.Split(flagBuildTags, ",")
-
-	args := flag.Args()
-	if len(args) == 0 {
-		args = []string{"."}
-	}
-
-	var dir string
-	if len(args) == 1 && isDirectory(args[0]) {
-		dir = args[0]
-	} else {
-		if len(tags) != 0 {
-			errExitf("-tags option applies only to directories, not individual files")
+	args := os.Args[1:]
+	if len(args) > 0 {
+		switch args[0] {
+		case "generate":
+			runGenerate("go-enum-codegen generate", args[1:])
+			return
+		case "check":
+			runCheck(args[1:])
+			return
+		case "list":
+			runList(args[1:])
+			return
+		case "init":
+			runInit(args[1:])
+			return
+		case "fix":
+			runFix(args[1:])
+			return
 		}
-		dir = filepath.Dir(args[0])
-	}
-
-	if flagJsonOnly && flagSQLOnly {
-		errExitf("`-only-json` and '-only-sql' are mutually exclusive")
-	}
-
-	var opts []goenumcodegen.Opt
-	if flagJsonOnly {
-		opts = append(opts, goenumcodegen.WithOnlyJsonMethods())
-	}
-	if flagSQLOnly {
-		opts = append(opts, goenumcodegen.WithOnlySQLMethods())
-	}
-	if flagErrOnUnk {
-		opts = append(opts, goenumcodegen.WithErrorOnUnknown())
-	}
-	if flagUseStringer {
-		opts = append(opts, goenumcodegen.WithUseStringer())
-	}
-	if flagDebug {
-		opts = append(opts, goenumcodegen.WithDebug())
-	}
-
-	g := goenumcodegen.NewGenerator(opts...)
-
-	err := g.ParsePackage(args, tags)
-	if err != nil {
-		errExitf("error parsing package: %v", err)
-	}
-
-	for _, typeName := range typeList {
-		err := g.Generate(typeName)
-		if err != nil {
-			errExitf("error generating enum code for type %s: %v", typeName, err)
-		}
-	}
-
-	g.WritePreambleAndImports(os.Args[1:])
-
-	src, err := g.Format()
-	if err != nil {
-		errExitf("error formatting code: %v", err)
-	}
-	outputName := flagOutput
-	if outputName == "" {
-		baseName := fmt.Sprintf("%s.gen.go", typeList[0])
-		outputName = filepath.Join(dir, strings.ToLower(baseName))
-	}
-
-	err = os.WriteFile(outputName, src, 0644)
-	if err != nil {
-		errExitf("failed to write output file: %v", err)
 	}
+	// a plain invocation is the same as "generate"
+	runGenerate("go-enum-codegen", args)
 }
 
-func isDirectory(name string) bool {
-	info, err := os.Stat(name)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return info.IsDir()
+// defineFlags defines the generation flags on fs and returns the values they are parsed into,
+// so "fix" parses go:generate directives exactly like a plain invocation.
+func defineFlags(fs *flag.FlagSet) *generateFlags {
+	f := &generateFlags{}
+	fs.StringVar(&f.typeNames, "type", "", "comma-separated list of type names; must be set")
+	fs.StringVar(&f.output, "output", "", "output file name; default srcdir/<type>.gen.go")
+	fs.BoolVar(&f.errOnUnk, "error-on-unknown", false, "whether to return an error if scanning or unmarshalling an unknown value; automatically set to true when iota is first set to \"_\" or there is no enum annotated \"// enum:unset\" or equal to the empty value of its underlying type; otherwise default is false and an unknown value will be assigned to the enum annotated \"// enum:unset\", or else the enum with the empty value of its underlying type")
+	fs.BoolVar(&f.errOnUnk, "e", false, "same as -error-on-unknown")
+	fs.StringVar(&f.buildTags, "tags", "", "comma-separated list of build tags to apply")
+	fs.BoolVar(&f.printUsage, "help", false, "show this help and exit")
+	fs.BoolVar(&f.printUsage, "h", false, "same as -help.")
+	fs.BoolVar(&f.printVersion, "version", false, "show version and exit")
+	fs.BoolVar(&f.jsonOnly, "json", false, "generate only json.Marshaler and json.Unmarshaler methods; default false")
+	fs.BoolVar(&f.sqlOnly, "sql", false, "generate only sql.Scanner and driver.Value methods; default false")
+	fs.BoolVar(&f.yaml, "yaml", false, "also generate yaml.Marshaler and yaml.Unmarshaler methods for gopkg.in/yaml.v3; default false")
+	fs.BoolVar(&f.flagMethods, "flag", false, "also generate Set and String methods implementing flag.Value; default false")
+	fs.BoolVar(&f.pflagMethods, "pflag", false, "also generate Set, String, and Type methods implementing pflag.Value; implies -flag; default false")
+	fs.BoolVar(&f.jsonSchema, "json-schema", false, "also write a JSON Schema file srcdir/<type>.schema.json for each type; default false")
+	fs.BoolVar(&f.openAPI, "openapi", false, "also write an OpenAPI components snippet srcdir/<type>.openapi.yaml for each type; default false")
+	fs.StringVar(&f.defaults, "default", "", "comma-separated list of constants, one per type in -type, that unknown values fall back to instead of the constant equal to the empty value of the underlying type; leave an entry empty to keep the default for a type")
+	fs.StringVar(&f.protoBridge, "proto-bridge", "", "comma-separated list of protoc-gen-go enums given as importpath.TypeName, one per type in -type, to generate ToProto and <Type>FromProto conversions for; leave an entry empty to skip a type")
+	fs.StringVar(&f.sqlDDL, "sql-ddl", "", "also write database DDL srcdir/<type>.sql for each type; one of postgres, mysql, or sqlite")
+	fs.BoolVar(&f.typeScript, "ts", false, "also write a TypeScript module srcdir/<type>.ts for each type; default false")
+	fs.BoolVar(&f.tsGuards, "ts-guards", false, "include an is<Type> type guard in TypeScript modules; implies -ts; default false")
+	fs.BoolVar(&f.tests, "tests", false, "also write round trip tests for the generated methods next to the output file as <type>.gen_test.go; default false")
+	fs.BoolVar(&f.bench, "bench", false, "also write benchmarks for the generated methods next to the output file as <type>.gen_test.go; default false")
+	fs.BoolVar(&f.zeroAlloc, "zero-alloc", false, "generate MarshalJSON and UnmarshalJSON methods that do not allocate for known values; the slice returned by MarshalJSON is shared; default false")
+	fs.BoolVar(&f.describe, "describe", false, "also generate a Description method returning the doc comment of each constant; default false")
+	fs.BoolVar(&f.null, "null", false, "also generate a Null<Type> struct mapping SQL NULL and JSON null to Valid being false; default false")
+	fs.BoolVar(&f.runtime, "runtime", false, "also generate Values and IsValid methods and register each type with github.com/ejfrick/go-enum-codegen/enum; default false")
+	fs.BoolVar(&f.typedErrors, "typed-errors", false, "return *enum.UnknownValueError from github.com/ejfrick/go-enum-codegen/enum for unknown values, matching enum.ErrUnknownValue with errors.Is; default false")
+	fs.BoolVar(&f.useStringer, "stringer", false, "use the String() method of the enum instead of the underlying integer value; default false")
+	fs.BoolVar(&f.checkStringer, "check-stringer", false, "with -stringer, fail if String() returns an empty or duplicate string for any constant; only String() methods built from switch and if statements, string constants, and map lookups can be checked; default false")
+	fs.StringVar(&f.templateDir, "template-dir", "", "directory of templates named like the built-in templates, e.g. scanner.tmpl or json.tmpl, that replace them when rendering the generated methods")
+	fs.BoolVar(&f.dumpModel, "dump-model", false, "print the model of each type as a JSON array to stdout instead of writing any file; default false")
+	fs.BoolVar(&f.debug, "debug", false, "output debug information about the tool")
+	return f
 }
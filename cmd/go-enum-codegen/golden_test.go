@@ -0,0 +1,259 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in examples/ with the current generator output")
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// TestGolden runs the generator on the examples with the flags given in Args,
+// exactly like go:generate would, and compares every file it writes with the one on disk.
+func TestGolden(t *testing.T) {
+	tt := []struct {
+		Name string
+		// Dir is the example package, relative to the repository root
+		Dir  string
+		Args []string
+	}{
+		{
+			Name: "error on unknown",
+			Dir:  "examples/err-on-unknown",
+			Args: []string{"-type", "MyEnum", "-error-on-unknown"},
+		},
+		{
+			Name: "signed integer",
+			Dir:  "examples/integer",
+			Args: []string{"-type", "MySignedEnum"},
+		},
+		{
+			Name: "unsigned integer",
+			Dir:  "examples/integer",
+			Args: []string{"-type", "MyUnsignedEnum"},
+		},
+		{
+			Name: "no default value",
+			Dir:  "examples/nodefault",
+			Args: []string{"-type", "MyEnum"},
+		},
+		{
+			Name: "string",
+			Dir:  "examples/string",
+			Args: []string{"-type", "MyEnum"},
+		},
+		{
+			Name: "stringer",
+			Dir:  "examples/stringer",
+			Args: []string{"-type", "MyEnum", "-stringer"},
+		},
+		{
+			Name: "yaml",
+			Dir:  "examples/yaml",
+			Args: []string{"-type", "MyEnum", "-yaml"},
+		},
+		{
+			Name: "pflag",
+			Dir:  "examples/flag",
+			Args: []string{"-type", "MyEnum", "-pflag", "-json"},
+		},
+		{
+			Name: "proto bridge",
+			Dir:  "examples/protobridge",
+			Args: []string{"-type", "Status", "-sql", "-proto-bridge=github.com/ejfrick/go-enum-codegen/examples/protobridge/pb.Status"},
+		},
+		{
+			Name: "round trip tests",
+			Dir:  "examples/roundtrip",
+			Args: []string{"-type", "MyEnum", "-stringer", "-tests", "-bench"},
+		},
+		{
+			Name: "zero allocation json",
+			Dir:  "examples/zeroalloc",
+			Args: []string{"-type", "MyEnum", "-json", "-zero-alloc", "-tests", "-bench"},
+		},
+		{
+			Name: "sized unsigned integer",
+			Dir:  "examples/sized",
+			Args: []string{"-type", "MySmallEnum", "-yaml", "-tests"},
+		},
+		{
+			Name: "sized signed integer",
+			Dir:  "examples/sized",
+			Args: []string{"-type", "MySignedSmallEnum", "-error-on-unknown"},
+		},
+		{
+			Name: "sized integer declaring its maximum",
+			Dir:  "examples/sized",
+			Args: []string{"-type", "MyTinyEnum", "-error-on-unknown", "-tests"},
+		},
+		{
+			Name: "rune",
+			Dir:  "examples/kinds",
+			Args: []string{"-type", "MyRuneEnum", "-yaml", "-flag", "-tests"},
+		},
+		{
+			Name: "bool",
+			Dir:  "examples/kinds",
+			Args: []string{"-type", "MyBoolEnum", "-error-on-unknown", "-tests"},
+		},
+		{
+			Name: "float",
+			Dir:  "examples/kinds",
+			Args: []string{"-type", "MyFloatEnum", "-zero-alloc", "-tests", "-sql-ddl=postgres"},
+		},
+		{
+			Name: "runtime",
+			Dir:  "examples/runtime",
+			Args: []string{"-type", "MyEnum", "-runtime", "-flag"},
+		},
+		{
+			Name: "null wrapper",
+			Dir:  "examples/null",
+			Args: []string{"-type", "MyEnum", "-null", "-tests"},
+		},
+		{
+			Name: "blank zero slot",
+			Dir:  "examples/unset",
+			Args: []string{"-type", "MyEnum", "-tests"},
+		},
+		{
+			Name: "unset annotation",
+			Dir:  "examples/unset",
+			Args: []string{"-type", "MyStatus", "-yaml", "-zero-alloc", "-tests"},
+		},
+		{
+			Name: "annotated default",
+			Dir:  "examples/fallback",
+			Args: []string{"-type", "Status", "-tests"},
+		},
+		{
+			Name: "default flag",
+			Dir:  "examples/fallback",
+			Args: []string{"-type", "Color", "-default", "ColorOther"},
+		},
+		{
+			Name: "typed errors",
+			Dir:  "examples/typederrors",
+			Args: []string{"-type", "MyEnum", "-flag", "-typed-errors"},
+		},
+		{
+			Name: "descriptions",
+			Dir:  "examples/describe",
+			Args: []string{"-type", "MyEnum", "-describe"},
+		},
+		{
+			Name: "descriptions naming other packages",
+			Dir:  "examples/describe",
+			Args: []string{"-type", "Rounding", "-describe"},
+		},
+		{
+			Name: "template override",
+			Dir:  "examples/templates",
+			Args: []string{"-type", "MyEnum", "-template-dir", "templates"},
+		},
+		{
+			Name: "schema companions",
+			Dir:  "examples/schema",
+			Args: []string{"-type", "MyEnum", "-json-schema", "-openapi", "-sql-ddl=postgres", "-ts-guards"},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			flags := flag.NewFlagSet("go-enum-codegen", flag.ContinueOnError)
+			flags.SetOutput(io.Discard)
+			f := defineFlags(flags)
+			require.NoError(t, flags.Parse(tc.Args))
+
+			dir := filepath.Join("..", "..", tc.Dir)
+			outputs := render(f, dir, tc.Args, flags.Args())
+
+			sources := make(map[string][]byte)
+			for _, out := range outputs {
+				if strings.HasSuffix(out.name, ".go") {
+					sources[out.name] = out.src
+				}
+				if *update {
+					require.NoError(t, os.WriteFile(out.name, out.src, 0644))
+					continue
+				}
+				golden, err := os.ReadFile(out.name)
+				require.NoError(t, err)
+				assert.Equal(t, string(golden), string(out.src), "generated output does not match %s; run go test -update to refresh it", out.name)
+			}
+
+			typeCheck(t, dir, sources)
+		})
+	}
+}
+
+// typeCheck type-checks the package in dir, including its tests, with the files named in sources replaced by their content.
+func typeCheck(t *testing.T, dir string, sources map[string][]byte) {
+	t.Helper()
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedTypes,
+		Dir:   dir,
+		Tests: true,
+	}, ".")
+	require.NoError(t, err)
+
+	// the test variant of the package holds both its files and its tests, if it has any
+	var pkg *packages.Package
+	for _, p := range pkgs {
+		if strings.HasSuffix(p.ID, ".test]") || (pkg == nil && p.ID == p.PkgPath) {
+			pkg = p
+		}
+	}
+	require.NotNil(t, pkg, "no package found in %s", dir)
+
+	replaced := make(map[string][]byte, len(sources))
+	for name, src := range sources {
+		abs, err := filepath.Abs(name)
+		require.NoError(t, err)
+		replaced[abs] = src
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range pkg.GoFiles {
+		var content any
+		if src, ok := replaced[name]; ok {
+			content = src
+		}
+		file, err := parser.ParseFile(fset, name, content, 0)
+		require.NoError(t, err)
+		files = append(files, file)
+	}
+
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			imp, ok := pkg.Imports[path]
+			if !ok {
+				return nil, fmt.Errorf("package %s is not imported by %s", path, pkg.PkgPath)
+			}
+			return imp.Types, nil
+		}),
+	}
+	_, err = conf.Check(pkg.PkgPath, fset, files, nil)
+	assert.NoError(t, err)
+}
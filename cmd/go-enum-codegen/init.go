@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// runInit implements "go-enum-codegen init", adding a go:generate directive with the given generate flags
+// above the declaration of the first type listed with -type.
+func runInit(args []string) {
+	flags := flag.NewFlagSet("go-enum-codegen init", flag.ExitOnError)
+	f := defineFlags(flags)
+	flags.Usage = func() {
+		usage(flags)
+	}
+	_ = flags.Parse(args)
+
+	if f.typeNames == "" {
+		errExitf("-type must be set")
+	}
+	typeNames := strings.Split(f.typeNames, ",")
+
+	dir := "."
+	switch len(flags.Args()) {
+	case 0:
+	case 1:
+		dir = flags.Args()[0]
+	default:
+		errExitf("init accepts a single directory")
+	}
+
+	canonical, err := canonicalArgs(args[:len(args)-len(flags.Args())])
+	if err != nil {
+		errExitf("invalid flags: %v", err)
+	}
+	directive := generateDirective + " go-enum-codegen" + formatArgs(canonical)
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		errExitf("failed to list Go files in %s: %v", dir, err)
+	}
+	var declFile string
+	var declSrc []byte
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(name)
+		if err != nil {
+			errExitf("failed to read %s: %v", name, err)
+		}
+		if line, typeName, ok := findDirective(src, typeNames); ok {
+			errExitf("%s:%d: type %s already has a go:generate directive", name, line, typeName)
+		}
+		if declFile != "" {
+			continue
+		}
+		updated, ok, err := addDirective(src, typeNames[0], directive)
+		if err != nil {
+			errExitf("failed to parse %s: %v", name, err)
+		}
+		if ok {
+			declFile, declSrc = name, updated
+		}
+	}
+	if declFile == "" {
+		errExitf("type %s is not declared in %s", typeNames[0], dir)
+	}
+
+	info, err := os.Stat(declFile)
+	if err != nil {
+		errExitf("failed to stat %s: %v", declFile, err)
+	}
+	if err := os.WriteFile(declFile, declSrc, info.Mode().Perm()); err != nil {
+		errExitf("failed to write %s: %v", declFile, err)
+	}
+	fmt.Printf("%s: %s\n", declFile, directive)
+}
+
+// findDirective returns the line of a go-enum-codegen directive in src generating one of typeNames.
+func findDirective(src []byte, typeNames []string) (int, string, bool) {
+	for i, line := range strings.Split(string(src), "\n") {
+		directive := strings.TrimRight(line, "\r")
+		if !strings.HasPrefix(directive, generateDirective+" ") {
+			continue
+		}
+		args, _, ok, err := codegenArgs(directive)
+		if err != nil || !ok {
+			continue
+		}
+		flags := flag.NewFlagSet("go-enum-codegen", flag.ContinueOnError)
+		flags.SetOutput(io.Discard)
+		f := defineFlags(flags)
+		if flags.Parse(args) != nil {
+			continue
+		}
+		for _, typeName := range strings.Split(f.typeNames, ",") {
+			if slices.Contains(typeNames, typeName) {
+				return i + 1, typeName, true
+			}
+		}
+	}
+	return 0, "", false
+}
+
+// addDirective inserts directive on the line above the type declaration of typeName in src,
+// separated from its doc comment the way gofmt formats directives, or returns false if src does not declare typeName.
+func addDirective(src []byte, typeName string, directive string) ([]byte, bool, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if spec.(*ast.TypeSpec).Name.Name != typeName {
+				continue
+			}
+			offset := fset.Position(gen.Pos()).Offset
+			lineStart := bytes.LastIndexByte(src[:offset], '\n') + 1
+			insert := directive + "\n"
+			if gen.Doc != nil {
+				insert = "//\n" + insert
+			}
+			return slices.Concat(src[:lineStart], []byte(insert), src[lineStart:]), true, nil
+		}
+	}
+	return src, false, nil
+}
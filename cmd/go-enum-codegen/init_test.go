@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddDirective(t *testing.T) {
+	const directive = "//go:generate go-enum-codegen -type Color"
+	tt := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Ok       bool
+	}{
+		{
+			Name:     "undocumented type",
+			Input:    "package colors\n\ntype Color int\n",
+			Expected: "package colors\n\n//go:generate go-enum-codegen -type Color\ntype Color int\n",
+			Ok:       true,
+		},
+		{
+			Name:     "documented type",
+			Input:    "package colors\n\n// Color is a color.\ntype Color int\n",
+			Expected: "package colors\n\n// Color is a color.\n//\n//go:generate go-enum-codegen -type Color\ntype Color int\n",
+			Ok:       true,
+		},
+		{
+			Name:     "grouped declaration",
+			Input:    "package colors\n\ntype (\n\tShade int\n\tColor int\n)\n",
+			Expected: "package colors\n\n//go:generate go-enum-codegen -type Color\ntype (\n\tShade int\n\tColor int\n)\n",
+			Ok:       true,
+		},
+		{
+			Name:     "type not declared",
+			Input:    "package colors\n\ntype Shade int\n",
+			Expected: "package colors\n\ntype Shade int\n",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, ok, err := addDirective([]byte(tc.Input), "Color", directive)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Ok, ok)
+			assert.Equal(t, tc.Expected, string(actual))
+		})
+	}
+}
+
+func TestFindDirective(t *testing.T) {
+	src := []byte("package colors\n\n//go:generate stringer -type Color\n//go:generate go-enum-codegen -type Shade,Color -yaml\ntype Color int\n")
+
+	line, typeName, ok := findDirective(src, []string{"Color"})
+	assert.True(t, ok)
+	assert.Equal(t, 4, line)
+	assert.Equal(t, "Color", typeName)
+
+	_, _, ok = findDirective(src, []string{"Size"})
+	assert.False(t, ok)
+}
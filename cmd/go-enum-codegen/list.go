@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	goenumcodegen "github.com/ejfrick/go-enum-codegen"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// listedEnum is the JSON form of a type printed by "go-enum-codegen list -json".
+type listedEnum struct {
+	Type      string           `json:"type"`
+	Kind      string           `json:"kind"`
+	Constants []listedConstant `json:"constants"`
+}
+
+type listedConstant struct {
+	Name    string `json:"name"`
+	Value   any    `json:"value"`
+	Default bool   `json:"default,omitempty"`
+	Unset   bool   `json:"unset,omitempty"`
+}
+
+// runList implements "go-enum-codegen list", printing every type of a package that go-enum-codegen
+// can generate code for along with its constants.
+func runList(args []string) {
+	listFlags := flag.NewFlagSet("go-enum-codegen list", flag.ExitOnError)
+	asJSON := listFlags.Bool("json", false, "print the types as a JSON array instead of a table")
+	buildTags := listFlags.String("tags", "", "comma-separated list of build tags to apply")
+	listFlags.Usage = func() {
+		fmt.Fprintf(listFlags.Output(), "Usage: go-enum-codegen list [-json] [-tags tags] [directory | files]\n\n")
+		fmt.Fprintf(listFlags.Output(), "Lists the types of a package, default the current directory, that go-enum-codegen can generate code for.\n")
+		listFlags.PrintDefaults()
+	}
+	_ = listFlags.Parse(args)
+
+	patterns := listFlags.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	g := goenumcodegen.NewGenerator()
+	if err := g.ParsePackage(patterns, strings.Split(*buildTags, ",")); err != nil {
+		errExitf("error parsing package: %v", err)
+	}
+	for _, typeName := range g.EnumTypes() {
+		if err := g.Generate(typeName); err != nil {
+			errExitf("error reading type %s: %v", typeName, err)
+		}
+	}
+
+	listed := listEnums(g.Enums())
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(listed); err != nil {
+			errExitf("failed to encode types: %v", err)
+		}
+		return
+	}
+	if err := writeListTable(os.Stdout, listed); err != nil {
+		errExitf("failed to print types: %v", err)
+	}
+}
+
+func listEnums(enums []goenumcodegen.Enum) []listedEnum {
+	listed := make([]listedEnum, 0, len(enums))
+	for _, e := range enums {
+		le := listedEnum{Type: e.TypeName, Kind: string(e.Kind), Constants: make([]listedConstant, len(e.Values))}
+		for i, v := range e.Values {
+			lit, err := v.Literal()
+			if err != nil {
+				lit = v.StrVal
+			}
+			le.Constants[i] = listedConstant{
+				Name:    v.Name,
+				Value:   lit,
+				Default: e.DefaultValue != nil && e.DefaultValue.Name == v.Name,
+				Unset:   v.Unset,
+			}
+		}
+		listed = append(listed, le)
+	}
+	return listed
+}
+
+// writeListTable prints one row per constant, with quoted values for string kinds.
+func writeListTable(w io.Writer, listed []listedEnum) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TYPE\tKIND\tCONSTANT\tVALUE\tNOTES")
+	for _, e := range listed {
+		for _, c := range e.Constants {
+			var notes []string
+			if c.Default {
+				notes = append(notes, "default")
+			}
+			if c.Unset {
+				notes = append(notes, "unset")
+			}
+			value := fmt.Sprint(c.Value)
+			if s, ok := c.Value.(string); ok {
+				value = fmt.Sprintf("%q", s)
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", e.Type, e.Kind, c.Name, value, strings.Join(notes, ", "))
+		}
+	}
+	return tw.Flush()
+}
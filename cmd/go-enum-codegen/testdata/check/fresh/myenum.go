@@ -0,0 +1,8 @@
+package myenum
+
+type MyEnum string
+
+const (
+	MyEnumOne MyEnum = "one"
+	MyEnumTwo MyEnum = "two"
+)
@@ -0,0 +1,10 @@
+package myenum
+
+type MyEnum string
+
+const (
+	MyEnumOne MyEnum = "one"
+	MyEnumTwo MyEnum = "two"
+)
+
+const MyEnumThree MyEnum = "three"
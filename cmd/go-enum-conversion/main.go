@@ -0,0 +1,16 @@
+// go-enum-conversion reports conversions of non-constant values into enums generated by go-enum-codegen,
+// which bypass the validation of the generated readers.
+//
+// It can be run directly or through go vet:
+//
+//	go vet -vettool=$(which go-enum-conversion) ./...
+package main
+
+import (
+	"github.com/ejfrick/go-enum-codegen/analysis/conversion"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(conversion.Analyzer)
+}
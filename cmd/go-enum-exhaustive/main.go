@@ -0,0 +1,16 @@
+// go-enum-exhaustive reports switch statements over enums generated by go-enum-codegen
+// that do not cover every constant and have no default case.
+//
+// It can be run directly or through go vet:
+//
+//	go vet -vettool=$(which go-enum-exhaustive) ./...
+package main
+
+import (
+	"github.com/ejfrick/go-enum-codegen/analysis/exhaustive"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(exhaustive.Analyzer)
+}
@@ -0,0 +1,105 @@
+package goenumcodegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type SQLDialect string
+
+const (
+	DialectPostgres SQLDialect = "postgres"
+	DialectMySQL    SQLDialect = "mysql"
+	DialectSQLite   SQLDialect = "sqlite"
+)
+
+// SQLDDL returns database DDL restricting a column to the values the generated Value method stores for typeName:
+// a CREATE TYPE ... AS ENUM (or CREATE DOMAIN for integers) for Postgres, an ENUM column fragment for MySQL,
+// and a CHECK constraint column fragment for SQLite. Generate must have been called for typeName first.
+func (g *Generator) SQLDDL(typeName string, dialect SQLDialect) ([]byte, error) {
+	e, err := g.enum(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	wire, err := g.wireValues(e)
+	if err != nil {
+		return nil, err
+	}
+
+	literals := make([]string, len(wire))
+	for i, w := range wire {
+		switch w := w.(type) {
+		case string:
+			literals[i] = "'" + strings.ReplaceAll(w, "'", "''") + "'"
+		case float64:
+			// Value widens float32 constants to float64, so store the widened value rather than the shortest float32 form
+			if BitSize(e.Values[i].BasicKind) == 32 {
+				w = float64(float32(w))
+			}
+			literals[i] = strconv.FormatFloat(w, 'g', -1, 64)
+		default:
+			literals[i] = fmt.Sprint(w)
+		}
+	}
+	list := strings.Join(literals, ", ")
+	name := strings.ToLower(upperSnake(typeName))
+	isString := e.Kind == TypeString || e.Kind == TypeRune
+
+	var s strings.Builder
+	_, _ = s.WriteString(fmt.Sprintf("-- Code generated by go-enum-codegen for %s; DO NOT EDIT.\n\n", typeName))
+	switch dialect {
+	case DialectPostgres:
+		if isString {
+			_, _ = s.WriteString(fmt.Sprintf("CREATE TYPE %s AS ENUM (%s);\n", name, list))
+		} else {
+			_, _ = s.WriteString(fmt.Sprintf("CREATE DOMAIN %s AS %s CHECK (VALUE IN (%s));\n", name, sqlColumnType(dialect, e.Kind), list))
+		}
+	case DialectMySQL:
+		if isString {
+			_, _ = s.WriteString(fmt.Sprintf("%s ENUM(%s)\n", name, list))
+		} else {
+			_, _ = s.WriteString(fmt.Sprintf("%s %s CHECK (%s IN (%s))\n", name, sqlColumnType(dialect, e.Kind), name, list))
+		}
+	case DialectSQLite:
+		colType := sqlColumnType(dialect, e.Kind)
+		if isString {
+			colType = "TEXT"
+		}
+		_, _ = s.WriteString(fmt.Sprintf("%s %s CHECK (%s IN (%s))\n", name, colType, name, list))
+	default:
+		return nil, fmt.Errorf("unsupported SQL dialect %q", dialect)
+	}
+
+	return []byte(s.String()), nil
+}
+
+// sqlColumnType returns the column type storing the values the generated Value method returns for a non-string kind.
+func sqlColumnType(dialect SQLDialect, kind ValueType) string {
+	switch dialect {
+	case DialectPostgres:
+		switch kind {
+		case TypeBool:
+			return "boolean"
+		case TypeFloat:
+			return "double precision"
+		default:
+			return "bigint"
+		}
+	case DialectMySQL:
+		switch kind {
+		case TypeBool:
+			return "BOOLEAN"
+		case TypeFloat:
+			return "DOUBLE"
+		default:
+			return "BIGINT"
+		}
+	default:
+		if kind == TypeFloat {
+			return "REAL"
+		}
+		return "INTEGER"
+	}
+}
@@ -0,0 +1,8 @@
+package goenumcodegen
+
+import "fmt"
+
+// DescriptionTableName returns the name of the package-level variable holding the doc comment of each constant of typeName.
+func DescriptionTableName(typeName string) string {
+	return fmt.Sprintf("_%s_descriptions", typeName)
+}
@@ -0,0 +1,99 @@
+// Package enum is the runtime support for types generated by go-enum-codegen with -runtime.
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Enum is implemented by every type generated with -runtime.
+type Enum[T any] interface {
+	comparable
+	fmt.Stringer
+	// Values returns every constant of the type in declaration order.
+	Values() []T
+	// IsValid reports whether the value is one of the constants of its type.
+	IsValid() bool
+}
+
+// Validator is the non-generic view of an Enum, for code handling values of any enum type.
+type Validator interface {
+	fmt.Stringer
+	IsValid() bool
+}
+
+// All returns every constant of T in declaration order.
+func All[T Enum[T]]() []T {
+	var zero T
+	return zero.Values()
+}
+
+// Parse returns the constant of T whose String method returns s,
+// or an *UnknownValueError if there is none.
+func Parse[T Enum[T]](s string) (T, error) {
+	var zero T
+	values := zero.Values()
+	for _, v := range values {
+		if v.String() == s {
+			return v, nil
+		}
+	}
+
+	valid := make([]string, len(values))
+	for i, v := range values {
+		valid[i] = v.String()
+	}
+	return zero, &UnknownValueError{Type: reflect.TypeFor[T]().Name(), Op: "parse", Input: s, Valid: valid}
+}
+
+// Validate returns an *UnknownValueError if v is an enum value that is not one of the constants of its type.
+// Values of other types are always valid.
+func Validate(v any) error {
+	val, ok := v.(Validator)
+	if !ok || val.IsValid() {
+		return nil
+	}
+
+	var valid []string
+	if values, ok := Lookup(reflect.TypeOf(v)); ok {
+		valid = make([]string, len(values))
+		for i, value := range values {
+			valid[i] = value.String()
+		}
+	}
+	return &UnknownValueError{Type: reflect.TypeOf(v).Name(), Op: "validate", Input: v, Valid: valid}
+}
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[reflect.Type]func() []Validator)
+)
+
+// Register records T so that Lookup can find its constants by reflect.Type.
+// Generated code calls Register from an init function.
+func Register[T Enum[T]]() {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[reflect.TypeFor[T]()] = func() []Validator {
+		all := All[T]()
+		values := make([]Validator, len(all))
+		for i, v := range all {
+			values[i] = v
+		}
+		return values
+	}
+}
+
+// Lookup returns the constants of the registered enum type t in declaration order,
+// or false if t was not registered.
+func Lookup(t reflect.Type) ([]Validator, bool) {
+	mu.RLock()
+	values, ok := registry[t]
+	mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	return values(), true
+}
@@ -0,0 +1,170 @@
+package enum_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ejfrick/go-enum-codegen/enum"
+	myenum "github.com/ejfrick/go-enum-codegen/examples/runtime"
+	typederrors "github.com/ejfrick/go-enum-codegen/examples/typederrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Input    string
+		Value    myenum.MyEnum
+		Expected string
+	}{
+		{
+			Name:  "known value",
+			Input: "foo",
+			Value: myenum.MyEnumFoo,
+		},
+		{
+			Name:     "unknown value",
+			Input:    "baz",
+			Expected: "failed to parse MyEnum value: unrecognized value `baz`, expected one of ``, `foo`, `bar`",
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			v, err := enum.Parse[myenum.MyEnum](tc.Input)
+			if tc.Expected == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Value, v)
+			} else {
+				assert.EqualError(t, err, tc.Expected)
+				assert.ErrorIs(t, err, enum.ErrUnknownValue)
+				var unknown *enum.UnknownValueError
+				if assert.ErrorAs(t, err, &unknown) {
+					assert.Equal(t, "MyEnum", unknown.Type)
+					assert.Equal(t, tc.Input, unknown.Input)
+				}
+			}
+		})
+	}
+}
+
+func TestAll(t *testing.T) {
+	assert.Equal(t, []myenum.MyEnum{myenum.MyEnumUnknown, myenum.MyEnumFoo, myenum.MyEnumBar}, enum.All[myenum.MyEnum]())
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, enum.Validate(myenum.MyEnumBar))
+	assert.NoError(t, enum.Validate("baz"))
+	err := enum.Validate(myenum.MyEnum("baz"))
+	assert.EqualError(t, err, "failed to validate MyEnum value: unrecognized value `baz`, expected one of ``, `foo`, `bar`")
+	var unknown *enum.UnknownValueError
+	if assert.ErrorAs(t, err, &unknown) {
+		assert.Equal(t, "MyEnum", unknown.Type)
+		assert.Equal(t, myenum.MyEnum("baz"), unknown.Input)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	values, ok := enum.Lookup(reflect.TypeFor[myenum.MyEnum]())
+	assert.True(t, ok)
+	assert.Equal(t, []enum.Validator{myenum.MyEnumUnknown, myenum.MyEnumFoo, myenum.MyEnumBar}, values)
+
+	_, ok = enum.Lookup(reflect.TypeFor[string]())
+	assert.False(t, ok)
+}
+
+func TestNull(t *testing.T) {
+	tt := []struct {
+		Name  string
+		SQL   any
+		JSON  string
+		Value enum.Null[myenum.MyEnum]
+	}{
+		{
+			Name:  "null",
+			SQL:   nil,
+			JSON:  "null",
+			Value: enum.Null[myenum.MyEnum]{},
+		},
+		{
+			Name:  "valid",
+			SQL:   "foo",
+			JSON:  `"foo"`,
+			Value: enum.Null[myenum.MyEnum]{V: myenum.MyEnumFoo, Valid: true},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			var scanned enum.Null[myenum.MyEnum]
+			assert.NoError(t, scanned.Scan(tc.SQL))
+			assert.Equal(t, tc.Value, scanned)
+			value, err := scanned.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.SQL, value)
+
+			var unmarshaled enum.Null[myenum.MyEnum]
+			assert.NoError(t, unmarshaled.UnmarshalJSON([]byte(tc.JSON)))
+			assert.Equal(t, tc.Value, unmarshaled)
+			data, err := unmarshaled.MarshalJSON()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.JSON, string(data))
+		})
+	}
+}
+
+func TestNullInvalidInput(t *testing.T) {
+	scanned := enum.Null[myenum.MyEnum]{V: myenum.MyEnumFoo, Valid: true}
+	assert.Error(t, scanned.Scan(struct{}{}))
+	assert.Equal(t, enum.Null[myenum.MyEnum]{}, scanned, "a failed Scan leaves the value null")
+
+	unmarshaled := enum.Null[myenum.MyEnum]{V: myenum.MyEnumFoo, Valid: true}
+	assert.Error(t, unmarshaled.UnmarshalJSON([]byte("{}")))
+	assert.Equal(t, enum.Null[myenum.MyEnum]{}, unmarshaled, "a failed UnmarshalJSON leaves the value null")
+}
+
+func TestUnknownValueError(t *testing.T) {
+	var got typederrors.MyEnum
+	tt := []struct {
+		Name     string
+		Read     func() error
+		Expected *enum.UnknownValueError
+	}{
+		{
+			Name:     "unmarshal",
+			Read:     func() error { return got.UnmarshalJSON([]byte("3")) },
+			Expected: &enum.UnknownValueError{Type: "MyEnum", Op: "unmarshal", Input: []byte("3"), Valid: []string{"1", "2"}},
+		},
+		{
+			Name:     "scan",
+			Read:     func() error { return got.Scan([]byte("3")) },
+			Expected: &enum.UnknownValueError{Type: "MyEnum", Op: "scan", Input: []byte("3"), Valid: []string{"1", "2"}},
+		},
+		{
+			Name:     "set",
+			Read:     func() error { return got.Set("03") },
+			Expected: &enum.UnknownValueError{Type: "MyEnum", Op: "set", Input: "03", Valid: []string{"1", "2"}},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Read()
+			assert.True(t, errors.Is(err, enum.ErrUnknownValue))
+			var unknown *enum.UnknownValueError
+			if assert.ErrorAs(t, err, &unknown) {
+				assert.Equal(t, tc.Expected, unknown)
+			}
+		})
+	}
+
+	err := got.UnmarshalJSON([]byte("3"))
+	assert.EqualError(t, err, "failed to unmarshal MyEnum value: unrecognized value `3`, expected one of `1`, `2`")
+
+	err = got.Scan(float64(3))
+	assert.False(t, errors.Is(err, enum.ErrUnknownValue), "conversion errors are not unknown values")
+}
@@ -0,0 +1,41 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownValue is matched by errors.Is for every *UnknownValueError.
+var ErrUnknownValue = errors.New("enum: unknown value")
+
+// UnknownValueError is returned by generated readers given a value that is not one of the constants of their type.
+type UnknownValueError struct {
+	// Type is the name of the enum type without its package, e.g. "MyEnum".
+	Type string
+	// Op is the operation that failed, e.g. "scan", "unmarshal", or "set".
+	Op string
+	// Input is the input as the caller passed it: the value given to Scan, the raw []byte given to
+	// UnmarshalJSON, the scalar of the YAML node, or the string given to Set or Parse.
+	Input any
+	// Valid lists the serialized form of every constant of Type.
+	Valid []string
+}
+
+func (e *UnknownValueError) Error() string {
+	input := e.Input
+	if data, ok := input.([]byte); ok {
+		input = string(data)
+	}
+	msg := fmt.Sprintf("failed to %s %s value: unrecognized value `%v`", e.Op, e.Type, input)
+	if len(e.Valid) == 0 {
+		return msg
+	}
+
+	return fmt.Sprintf("%s, expected one of `%s`", msg, strings.Join(e.Valid, "`, `"))
+}
+
+// Is reports whether target is ErrUnknownValue.
+func (e *UnknownValueError) Is(target error) bool {
+	return target == ErrUnknownValue
+}
@@ -0,0 +1,82 @@
+package enum
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Null represents a T that may be null, like sql.Null. Non-null values are read and written
+// by the generated Scan, Value, UnmarshalJSON and MarshalJSON methods of T.
+type Null[T Enum[T]] struct {
+	V     T
+	Valid bool
+}
+
+// Scan implements sql.Scanner for Null
+func (n *Null[T]) Scan(value any) error {
+	// clear the previous value so that n is null if the Scan of T fails
+	var zero T
+	n.V, n.Valid = zero, false
+	if value == nil {
+		return nil
+	}
+	scanner, ok := any(&n.V).(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("enum: %T does not implement sql.Scanner", n.V)
+	}
+	if err := scanner.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer for Null
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	valuer, ok := any(n.V).(driver.Valuer)
+	if !ok {
+		return nil, fmt.Errorf("enum: %T does not implement driver.Valuer", n.V)
+	}
+
+	return valuer.Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Null
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	// clear the previous value so that n is null if the UnmarshalJSON of T fails
+	var zero T
+	n.V, n.Valid = zero, false
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	unmarshaler, ok := any(&n.V).(json.Unmarshaler)
+	if !ok {
+		return fmt.Errorf("enum: %T does not implement json.Unmarshaler", n.V)
+	}
+	if err := unmarshaler.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for Null
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	marshaler, ok := any(n.V).(json.Marshaler)
+	if !ok {
+		return nil, fmt.Errorf("enum: %T does not implement json.Marshaler", n.V)
+	}
+
+	return marshaler.MarshalJSON()
+}
@@ -0,0 +1,13 @@
+package myenum
+
+type MyEnum string
+
+const (
+	// MyEnumDraft is a document that has not been submitted yet.
+	MyEnumDraft MyEnum = "draft"
+	// MyEnumReview is a document waiting for a reviewer,
+	// who may send it back as a draft.
+	MyEnumReview    MyEnum = "review"
+	MyEnumPublished MyEnum = "published" // visible to everyone
+	MyEnumArchived  MyEnum = "archived"
+)
@@ -0,0 +1,10 @@
+package myenum
+
+type Rounding string
+
+const (
+	// RoundingDown rounds toward negative infinity, like math.Floor.
+	RoundingDown Rounding = "down"
+	// RoundingNearest rounds half away from zero, like strconv.FormatFloat with a precision of 0.
+	RoundingNearest Rounding = "nearest"
+)
@@ -0,0 +1,20 @@
+package status
+
+type Status int
+
+const (
+	StatusActive  Status = 1
+	StatusRetired Status = 2
+	// StatusUnknown is assigned to statuses this version does not know about.
+	// enum:default
+	StatusUnknown Status = 99
+)
+
+type Color string
+
+const (
+	ColorNone  Color = ""
+	ColorRed   Color = "red"
+	ColorGreen Color = "green"
+	ColorOther Color = "other"
+)
@@ -0,0 +1,10 @@
+package myenum
+
+type MyEnum string
+
+const (
+	MyEnumEmpty MyEnum = ""
+	MyEnumOne   MyEnum = "One"
+	MyEnumTwo   MyEnum = "Two"
+	MyEnumThree MyEnum = "Three"
+)
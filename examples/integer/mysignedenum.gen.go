@@ -5,14 +5,45 @@ package myenum
 import (
 	"database/sql/driver"
 	"fmt"
+	"math"
 	"strconv"
 )
 
 // Scan implements sql.Scanner for MySignedEnum
 func (m *MySignedEnum) Scan(value interface{}) error {
-	i, ok := value.(int)
-	if !ok {
-		return fmt.Errorf("failed to scan MySignedEnum value: expected type `int`, got `%T`", value)
+	var i int64
+	switch v := value.(type) {
+	case int64:
+		i = v
+	case int:
+		i = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return fmt.Errorf("failed to scan MySignedEnum value: `%v` is out of range for `int`", v)
+		}
+		i = int64(v)
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return fmt.Errorf("failed to scan MySignedEnum value: `%v` is out of range for `int`", v)
+		}
+		i = int64(v)
+	case []byte:
+		parsed, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to scan MySignedEnum value: could not convert `[]byte` to `int`: %v", err)
+		}
+		i = parsed
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to scan MySignedEnum value: could not convert `string` to `int`: %v", err)
+		}
+		i = parsed
+	default:
+		return fmt.Errorf("failed to scan MySignedEnum value: expected an integer or a string, got `%T`", value)
+	}
+	if i < math.MinInt || i > math.MaxInt {
+		return fmt.Errorf("failed to scan MySignedEnum value: `%v` is out of range for `int`", i)
 	}
 	switch i {
 	case 1, 2, 3:
@@ -5,14 +5,45 @@ package myenum
 import (
 	"database/sql/driver"
 	"fmt"
+	"math"
 	"strconv"
 )
 
 // Scan implements sql.Scanner for MyUnsignedEnum
 func (m *MyUnsignedEnum) Scan(value interface{}) error {
-	u, ok := value.(uint)
-	if !ok {
-		return fmt.Errorf("failed to scan MyUnsignedEnum value: expected type `uint`, got `%T`", value)
+	var u uint64
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("failed to scan MyUnsignedEnum value: `%v` is out of range for `uint`", v)
+		}
+		u = uint64(v)
+	case int:
+		if v < 0 {
+			return fmt.Errorf("failed to scan MyUnsignedEnum value: `%v` is out of range for `uint`", v)
+		}
+		u = uint64(v)
+	case uint64:
+		u = v
+	case uint:
+		u = uint64(v)
+	case []byte:
+		parsed, err := strconv.ParseUint(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to scan MyUnsignedEnum value: could not convert `[]byte` to `uint`: %v", err)
+		}
+		u = parsed
+	case string:
+		parsed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to scan MyUnsignedEnum value: could not convert `string` to `uint`: %v", err)
+		}
+		u = parsed
+	default:
+		return fmt.Errorf("failed to scan MyUnsignedEnum value: expected an integer or a string, got `%T`", value)
+	}
+	if u > math.MaxUint {
+		return fmt.Errorf("failed to scan MyUnsignedEnum value: `%v` is out of range for `uint`", u)
 	}
 	switch u {
 	case 1, 2, 3:
@@ -0,0 +1,25 @@
+package myenum
+
+type MyRuneEnum rune
+
+const (
+	MyRuneEnumA MyRuneEnum = 'a'
+	MyRuneEnumB MyRuneEnum = 'b'
+	MyRuneEnumÉ MyRuneEnum = 'é'
+)
+
+type MyBoolEnum bool
+
+const (
+	MyBoolEnumOff MyBoolEnum = false
+	MyBoolEnumOn  MyBoolEnum = true
+)
+
+type MyFloatEnum float32
+
+const (
+	MyFloatEnumNone    MyFloatEnum = 0
+	MyFloatEnumTenth   MyFloatEnum = 0.1
+	MyFloatEnumQuarter MyFloatEnum = 0.25
+	MyFloatEnumThird   MyFloatEnum = 1.0 / 3
+)
@@ -0,0 +1,9 @@
+package myenum
+
+type MyEnum int
+
+const (
+	MyEnumUnknown MyEnum = iota
+	MyEnumFoo
+	MyEnumBar
+)
@@ -0,0 +1,11 @@
+// Package pb stands in for protoc-gen-go output so the example does not depend on protobuf.
+package pb
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_ACTIVE      Status = 1
+	Status_STATUS_IN_PROGRESS Status = 2
+	Status_STATUS_DONE        Status = 3
+)
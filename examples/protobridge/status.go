@@ -0,0 +1,10 @@
+package protobridge
+
+type Status string
+
+const (
+	StatusUnspecified Status = ""
+	StatusActive      Status = "active"
+	StatusInProgress  Status = "in_progress"
+	StatusDone        Status = "done"
+)
@@ -0,0 +1,23 @@
+package myenum
+
+type MyEnum int
+
+const (
+	MyEnumZero MyEnum = iota
+	MyEnumOne
+	MyEnumTwo
+	MyEnumThree
+)
+
+func (e MyEnum) String() string {
+	switch e {
+	case MyEnumOne:
+		return "one"
+	case MyEnumTwo:
+		return "two"
+	case MyEnumThree:
+		return "three"
+	default:
+		return "zero"
+	}
+}
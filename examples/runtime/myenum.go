@@ -0,0 +1,9 @@
+package myenum
+
+type MyEnum string
+
+const (
+	MyEnumUnknown MyEnum = ""
+	MyEnumFoo     MyEnum = "foo"
+	MyEnumBar     MyEnum = "bar"
+)
@@ -0,0 +1,11 @@
+package myenum
+
+type MyEnum string
+
+const (
+	// MyEnumPending is a request that has not been picked up yet.
+	MyEnumPending MyEnum = "pending"
+	// MyEnumActive is a request that is being worked on.
+	MyEnumActive MyEnum = "active"
+	MyEnumDone   MyEnum = "done" // finished, successfully or not
+)
@@ -0,0 +1,25 @@
+package myenum
+
+type MySmallEnum uint8
+
+const (
+	MySmallEnumZero MySmallEnum = iota
+	MySmallEnumOne
+	MySmallEnumTwo
+)
+
+type MySignedSmallEnum int16
+
+const (
+	MySignedSmallEnumMinusOne MySignedSmallEnum = iota - 1
+	MySignedSmallEnumZero
+	MySignedSmallEnumOne
+)
+
+type MyTinyEnum int8
+
+const (
+	MyTinyEnumMin  MyTinyEnum = -1
+	MyTinyEnumZero MyTinyEnum = 0
+	MyTinyEnumMax  MyTinyEnum = 127
+)
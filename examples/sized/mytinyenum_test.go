@@ -0,0 +1,77 @@
+package myenum
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMyTinyEnumScan(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Value    interface{}
+		Expected MyTinyEnum
+		// substring of the error of Scan, if any
+		ExpectedErr string
+	}{
+		{
+			Name:     "int64",
+			Value:    int64(127),
+			Expected: MyTinyEnumMax,
+		},
+		{
+			Name:     "uint64",
+			Value:    uint64(0),
+			Expected: MyTinyEnumZero,
+		},
+		{
+			Name:     "bytes",
+			Value:    []byte("-1"),
+			Expected: MyTinyEnumMin,
+		},
+		{
+			Name:     "string",
+			Value:    "127",
+			Expected: MyTinyEnumMax,
+		},
+		{
+			Name:        "int64 overflowing int8",
+			Value:       int64(383),
+			ExpectedErr: "`383` is out of range for `int8`",
+		},
+		{
+			Name:        "uint64 overflowing int64",
+			Value:       uint64(math.MaxUint64),
+			ExpectedErr: "out of range for `int8`",
+		},
+		{
+			Name:        "string overflowing int8",
+			Value:       "-129",
+			ExpectedErr: "`-129` is out of range for `int8`",
+		},
+		{
+			Name:        "not a number",
+			Value:       []byte("max"),
+			ExpectedErr: "could not convert `[]byte` to `int8`",
+		},
+		{
+			Name:        "float",
+			Value:       float64(1),
+			ExpectedErr: "expected an integer or a string, got `float64`",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			var got MyTinyEnum
+			err := got.Scan(tc.Value)
+			if tc.ExpectedErr != "" {
+				assert.ErrorContains(t, err, tc.ExpectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, got)
+		})
+	}
+}
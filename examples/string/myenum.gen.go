@@ -4,7 +4,9 @@ package myenum
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
 // Scan implements sql.Scanner for MyEnum
@@ -14,7 +16,7 @@ func (m *MyEnum) Scan(value interface{}) error {
 		return fmt.Errorf("failed to scan MyEnum value: expected type `string`, got `%T`", value)
 	}
 	switch str {
-	case "One", "Three", "Two":
+	case "One", "Two", "Three":
 		*m = MyEnum(str)
 	default:
 		*m = MyEnumEmpty
@@ -30,9 +32,12 @@ func (m MyEnum) Value() (driver.Value, error) {
 
 // UnmarshalJSON implements json.Unmarshaler for MyEnum
 func (m *MyEnum) UnmarshalJSON(data []byte) error {
-	str := string(data)
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("failed to unmarshal MyEnum value: could not decode json to `string`: %v", err)
+	}
 	switch str {
-	case "One", "Three", "Two":
+	case "One", "Two", "Three":
 		*m = MyEnum(str)
 	default:
 		*m = MyEnumEmpty
@@ -43,5 +48,5 @@ func (m *MyEnum) UnmarshalJSON(data []byte) error {
 
 // MarshalJSON implements json.Marshaler for MyEnum
 func (m MyEnum) MarshalJSON() ([]byte, error) {
-	return []byte(m), nil
+	return strconv.AppendQuote(nil, string(m)), nil
 }
@@ -4,22 +4,28 @@ package myenum
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
+// _MyEnum_byString maps the String() of each MyEnum constant back to the constant
+var _MyEnum_byString = map[string]MyEnum{
+	MyEnumZero.String():  MyEnumZero,
+	MyEnumOne.String():   MyEnumOne,
+	MyEnumTwo.String():   MyEnumTwo,
+	MyEnumThree.String(): MyEnumThree,
+}
+
 // Scan implements sql.Scanner for MyEnum
 func (e *MyEnum) Scan(value interface{}) error {
 	str, ok := value.(string)
 	if !ok {
 		return fmt.Errorf("failed to scan MyEnum value: expected type `string`, got `%T`", value)
 	}
-	switch str {
-	case MyEnumOne.String():
-		*e = MyEnumOne
-	case MyEnumTwo.String():
-		*e = MyEnumTwo
-	case MyEnumThree.String():
-		*e = MyEnumThree
+	switch parsed, found := _MyEnum_byString[str]; {
+	case found:
+		*e = parsed
 	default:
 		*e = MyEnumZero
 	}
@@ -34,14 +40,13 @@ func (e MyEnum) Value() (driver.Value, error) {
 
 // UnmarshalJSON implements json.Unmarshaler for MyEnum
 func (e *MyEnum) UnmarshalJSON(data []byte) error {
-	str := string(data)
-	switch str {
-	case MyEnumOne.String():
-		*e = MyEnumOne
-	case MyEnumTwo.String():
-		*e = MyEnumTwo
-	case MyEnumThree.String():
-		*e = MyEnumThree
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("failed to unmarshal MyEnum value: could not decode json to `string`: %v", err)
+	}
+	switch parsed, found := _MyEnum_byString[str]; {
+	case found:
+		*e = parsed
 	default:
 		*e = MyEnumZero
 	}
@@ -51,5 +56,5 @@ func (e *MyEnum) UnmarshalJSON(data []byte) error {
 
 // MarshalJSON implements json.Marshaler for MyEnum
 func (e MyEnum) MarshalJSON() ([]byte, error) {
-	return []byte(e.String()), nil
+	return strconv.AppendQuote(nil, e.String()), nil
 }
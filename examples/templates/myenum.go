@@ -0,0 +1,22 @@
+package myenum
+
+type MyEnum string
+
+const (
+	MyEnumOne   MyEnum = "One"
+	MyEnumTwo   MyEnum = "Two"
+	MyEnumThree MyEnum = "Three"
+)
+
+// ScanError wraps the errors returned by the Scan methods generated from templates/scanner.tmpl.
+type ScanError struct {
+	Err error
+}
+
+func (e *ScanError) Error() string {
+	return "scan: " + e.Err.Error()
+}
+
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
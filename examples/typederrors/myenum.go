@@ -0,0 +1,8 @@
+package myenum
+
+type MyEnum int
+
+const (
+	MyEnumFoo MyEnum = iota + 1
+	MyEnumBar
+)
@@ -0,0 +1,19 @@
+package myenum
+
+type MyEnum int
+
+const (
+	_ MyEnum = iota
+	MyEnumFoo
+	MyEnumBar
+)
+
+type MyStatus string
+
+const (
+	// MyStatusUnset is the status of a record that was never assigned one.
+	// enum:unset
+	MyStatusUnset   MyStatus = ""
+	MyStatusActive  MyStatus = "active"
+	MyStatusRetired MyStatus = "retired"
+)
@@ -0,0 +1,53 @@
+package myenum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMyStatusEncodingJSON(t *testing.T) {
+	type record struct {
+		Status MyStatus `json:"status"`
+	}
+	tt := []struct {
+		Name     string
+		Value    MyStatus
+		Expected string
+		// value read back by json.Unmarshal
+		Decoded MyStatus
+	}{
+		{
+			Name:     "unset",
+			Value:    MyStatusUnset,
+			Expected: `{"status":""}`,
+			Decoded:  MyStatusUnset,
+		},
+		{
+			Name:     "active",
+			Value:    MyStatusActive,
+			Expected: `{"status":"active"}`,
+			Decoded:  MyStatusActive,
+		},
+		{
+			Name:     "unknown",
+			Value:    MyStatus("paused"),
+			Expected: `{"status":"paused"}`,
+			Decoded:  MyStatusUnset,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			data, err := json.Marshal(record{Status: tc.Value})
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.Expected, string(data))
+
+			var got record
+			require.NoError(t, json.Unmarshal(data, &got))
+			assert.Equal(t, tc.Decoded, got.Status)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package myenum
+
+type MyEnum int
+
+const (
+	MyEnumZero MyEnum = iota
+	MyEnumOne
+	MyEnumTwo
+	MyEnumThree
+)
@@ -6,6 +6,9 @@ import (
 	"go/token"
 	"go/types"
 	"log"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 type File struct {
@@ -52,24 +55,48 @@ func (f *File) GenDecl(node ast.Node) bool {
 		}
 		for _, name := range vspec.Names {
 			if name.Name == "_" {
+				// a blank zero slot, as in `_ MyEnum = iota`, marks the zero value as unset
+				if obj, ok := f.pkg.defs[name].(*types.Const); ok && isZero(obj.Val()) {
+					f.hasUnset = true
+				}
 				continue
 			}
 			obj, ok := f.pkg.defs[name]
 			if !ok {
 				log.Fatalf("no value for constant %s", name)
 			}
-			info := obj.Type().Underlying().(*types.Basic).Info()
-			if info&types.IsInteger == 0 && info&types.IsString == 0 {
-				log.Fatalf("can't handle non-interger, non-string constant type %s", typ)
+			basic := obj.Type().Underlying().(*types.Basic)
+			info := basic.Info()
+			if info&(types.IsInteger|types.IsString|types.IsBoolean|types.IsFloat) == 0 {
+				log.Fatalf("can't handle constant type %s with underlying type %s", typ, basic.Name())
 			}
 			value := obj.(*types.Const).Val()
+			annotations := ValueAnnotations(decl, vspec)
 			v := Value{
-				Name:   name.Name,
-				StrVal: value.String(),
+				Name:      name.Name,
+				StrVal:    value.String(),
+				Doc:       ValueDoc(decl, vspec),
+				BasicKind: basic.Kind(),
+				Unset:     slices.Contains(annotations, AnnotationUnset),
+				Default:   slices.Contains(annotations, AnnotationDefault),
+			}
+			if f.pkg.fset != nil {
+				pos := f.pkg.fset.Position(name.Pos())
+				v.Position = Position{Filename: pos.Filename, Line: pos.Line, Column: pos.Column}
 			}
-			if value.Kind() == constant.String {
+			switch {
+			case value.Kind() == constant.String:
 				v.ValType = TypeString
-			} else {
+			case value.Kind() == constant.Bool:
+				v.ValType = TypeBool
+			case info&types.IsFloat != 0:
+				// constant.Value.String() rounds floats, so format the exact value of the typed constant instead
+				f, _ := constant.Float64Val(constant.ToFloat(value))
+				v.ValType = TypeFloat
+				v.StrVal = strconv.FormatFloat(f, 'g', -1, BitSize(basic.Kind()))
+			case basic.Name() == "rune":
+				v.ValType = TypeRune
+			default:
 				v.IsStringer = IsStringer(obj.Type().(*types.Named))
 				if info&types.IsUnsigned != 0 {
 					v.ValType = TypeUnsigned
@@ -105,3 +132,87 @@ func IsStringer(obj *types.Named) bool {
 
 	return false
 }
+
+// ValueDoc returns the doc comment of a constant spec, falling back to its trailing line comment.
+// Ungrouped declarations carry their doc comment on the declaration itself.
+func ValueDoc(decl *ast.GenDecl, vspec *ast.ValueSpec) string {
+	for _, group := range valueComments(decl, vspec) {
+		if doc := commentText(group); doc != "" {
+			return doc
+		}
+	}
+
+	return ""
+}
+
+// annotationPrefix starts a go-enum-codegen annotation in a constant comment, as in "// enum:unset".
+const annotationPrefix = "enum:"
+
+const (
+	// AnnotationUnset marks the constant holding the unset state of its type, which null and unknown values are read as.
+	AnnotationUnset = "unset"
+	// AnnotationDefault marks the constant unknown values fall back to.
+	AnnotationDefault = "default"
+)
+
+// ValueAnnotations returns the go-enum-codegen annotations of a constant spec, e.g. "unset" for "// enum:unset".
+func ValueAnnotations(decl *ast.GenDecl, vspec *ast.ValueSpec) []string {
+	var annotations []string
+	for _, group := range valueComments(decl, vspec) {
+		for _, c := range group.List {
+			if annotation, ok := strings.CutPrefix(commentLine(c.Text), annotationPrefix); ok {
+				annotations = append(annotations, strings.TrimSpace(annotation))
+			}
+		}
+	}
+
+	return annotations
+}
+
+// valueComments returns the comment groups describing a constant spec, in order of precedence.
+func valueComments(decl *ast.GenDecl, vspec *ast.ValueSpec) []*ast.CommentGroup {
+	var groups []*ast.CommentGroup
+	if vspec.Doc != nil {
+		groups = append(groups, vspec.Doc)
+	}
+	if !decl.Lparen.IsValid() && decl.Doc != nil {
+		groups = append(groups, decl.Doc)
+	}
+	if vspec.Comment != nil {
+		groups = append(groups, vspec.Comment)
+	}
+
+	return groups
+}
+
+// commentText returns the text of group without go-enum-codegen annotations.
+func commentText(group *ast.CommentGroup) string {
+	var lines []string
+	for _, line := range strings.Split(group.Text(), "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), annotationPrefix) {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func commentLine(text string) string {
+	text = strings.TrimPrefix(text, "//")
+	text = strings.TrimPrefix(text, "/*")
+	text = strings.TrimSuffix(text, "*/")
+	return strings.TrimSpace(text)
+}
+
+func isZero(value constant.Value) bool {
+	switch value.Kind() {
+	case constant.String:
+		return constant.StringVal(value) == ""
+	case constant.Bool:
+		return !constant.BoolVal(value)
+	case constant.Int, constant.Float:
+		return constant.Sign(value) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,60 @@
+package goenumcodegen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueDocAndAnnotations(t *testing.T) {
+	tt := []struct {
+		Name        string
+		Src         string
+		Doc         string
+		Annotations []string
+	}{
+		{
+			Name: "doc comment",
+			Src:  "const (\n\t// A is the first value.\n\tA T = 1\n)",
+			Doc:  "A is the first value.",
+		},
+		{
+			Name:        "annotated doc comment",
+			Src:         "const (\n\t// A is the first value.\n\t// enum:unset\n\tA T = 1\n)",
+			Doc:         "A is the first value.",
+			Annotations: []string{"unset"},
+		},
+		{
+			Name:        "directive style annotation",
+			Src:         "const (\n\t//enum:unset\n\tA T = 1\n)",
+			Annotations: []string{"unset"},
+		},
+		{
+			Name:        "trailing annotation",
+			Src:         "const (\n\tA T = 1 // enum:unset\n)",
+			Annotations: []string{"unset"},
+		},
+		{
+			Name:        "ungrouped declaration",
+			Src:         "// A is the only value.\n// enum:unset\nconst A T = 1",
+			Doc:         "A is the only value.",
+			Annotations: []string{"unset"},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			file, err := parser.ParseFile(token.NewFileSet(), "x.go", "package x\n\ntype T int\n\n"+tc.Src+"\n", parser.ParseComments)
+			require.NoError(t, err)
+			decl := file.Decls[1].(*ast.GenDecl)
+			vspec := decl.Specs[0].(*ast.ValueSpec)
+			assert.Equal(t, tc.Doc, ValueDoc(decl, vspec))
+			assert.Equal(t, tc.Annotations, ValueAnnotations(decl, vspec))
+		})
+	}
+}
@@ -4,33 +4,71 @@ import (
 	"bytes"
 	"cmp"
 	"fmt"
-	"github.com/ejfrick/cuts"
 	"go/ast"
 	"go/format"
+	"go/types"
 	"golang.org/x/tools/go/packages"
 	"log"
+	"path"
 	"slices"
+	"strconv"
 	"strings"
+	"text/template"
 )
 
 type Generator struct {
-	buf bytes.Buffer
-	pkg *Package
+	buf     bytes.Buffer
+	testBuf bytes.Buffer
+	pkg     *Package
 
 	kinds []ValueType
+	enums []Enum
+	tags  []string
+
+	// import path to package name of packages referenced by generated code
+	imports map[string]string
+	// import path to package name of packages referenced by generated tests
+	testImports map[string]string
+	// protobuf package loaded for each proto bridge, keyed by import path
+	protoPkgs map[string]*Package
 
 	// generator config
 	doJson      bool
 	doScanValue bool
+	doYaml      bool
+	doFlag      bool
+	doPflag     bool
+	doTests     bool
+	doBench     bool
+	zeroAlloc   bool
+	doRuntime   bool
+	doNull      bool
+	typedErrors bool
+	doDescribe  bool
 	errOnUnk    bool
 	useString   bool
+	checkString bool
 	debug       bool
+	// type name to "importpath.ProtoType"
+	protoBridges map[string]string
+	// type name to the name of the constant unknown values fall back to
+	defaultNames map[string]string
+	// directory of templates overriding the built-in ones
+	templateDir string
+	templates   *template.Template
 
 	// per-type info
 	// reset after each run
 	isStringer   bool
 	hasUnset     bool
 	defaultValue *Value
+	// defaultValue was chosen with WithDefault, "// enum:default", or "// enum:unset" rather than by its zero value
+	explicitDefault bool
+	unset           *Value
+	// every constant of the type in declaration order
+	declared    []Value
+	basicKind   types.BasicKind
+	wroteString bool
 }
 
 func NewGenerator(opts ...Opt) *Generator {
@@ -68,6 +106,96 @@ func WithOnlySQLMethods() Opt {
 	}
 }
 
+func WithYamlMethods() Opt {
+	return func(g *Generator) {
+		g.doYaml = true
+	}
+}
+
+func WithFlagMethods() Opt {
+	return func(g *Generator) {
+		g.doFlag = true
+	}
+}
+
+func WithPflagMethods() Opt {
+	return func(g *Generator) {
+		g.doFlag = true
+		g.doPflag = true
+	}
+}
+
+// WithProtoBridge generates conversions between typeName and the protoc-gen-go enum protoType,
+// given as "importpath.TypeName".
+func WithProtoBridge(typeName string, protoType string) Opt {
+	return func(g *Generator) {
+		if g.protoBridges == nil {
+			g.protoBridges = make(map[string]string)
+		}
+		g.protoBridges[typeName] = protoType
+	}
+}
+
+// WithDefault makes unknown values of typeName fall back to the constant named constName
+// instead of the constant equal to the zero value.
+func WithDefault(typeName string, constName string) Opt {
+	return func(g *Generator) {
+		if g.defaultNames == nil {
+			g.defaultNames = make(map[string]string)
+		}
+		g.defaultNames[typeName] = constName
+	}
+}
+
+func WithTests() Opt {
+	return func(g *Generator) {
+		g.doTests = true
+	}
+}
+
+func WithBenchmarks() Opt {
+	return func(g *Generator) {
+		g.doBench = true
+	}
+}
+
+// WithZeroAllocJSON generates MarshalJSON and UnmarshalJSON methods that do not allocate for known values.
+func WithZeroAllocJSON() Opt {
+	return func(g *Generator) {
+		g.zeroAlloc = true
+	}
+}
+
+// WithRuntime generates the Values and IsValid methods of enum.Enum and registers each type
+// with the runtime package at RuntimeImportPath.
+func WithRuntime() Opt {
+	return func(g *Generator) {
+		g.doRuntime = true
+	}
+}
+
+// WithNullWrapper generates a Null<Type> struct for each type that maps SQL NULL and JSON null to Valid being false.
+func WithNullWrapper() Opt {
+	return func(g *Generator) {
+		g.doNull = true
+	}
+}
+
+// WithTypedErrors makes readers return an *enum.UnknownValueError from the runtime package at RuntimeImportPath
+// for unknown values, which matches enum.ErrUnknownValue with errors.Is.
+func WithTypedErrors() Opt {
+	return func(g *Generator) {
+		g.typedErrors = true
+	}
+}
+
+// WithDescriptions generates a Description method returning the doc comment of each constant.
+func WithDescriptions() Opt {
+	return func(g *Generator) {
+		g.doDescribe = true
+	}
+}
+
 func WithErrorOnUnknown() Opt {
 	return func(g *Generator) {
 		g.errOnUnk = true
@@ -80,6 +208,14 @@ func WithUseStringer() Opt {
 	}
 }
 
+// WithStringerCheck makes Generate fail when, with WithUseStringer, the String method of a type
+// returns an empty or duplicate string for any of its constants.
+func WithStringerCheck() Opt {
+	return func(g *Generator) {
+		g.checkString = true
+	}
+}
+
 func WithDebug() Opt {
 	return func(g *Generator) {
 		g.debug = true
@@ -99,36 +235,60 @@ func (g *Generator) options(opts ...Opt) {
 }
 
 func (g *Generator) ParsePackage(patterns []string, tags []string) error {
+	g.tags = tags
+	pkg, err := g.loadPackage(patterns)
+	if err != nil {
+		return err
+	}
+	g.pkg = pkg
+
+	return nil
+}
+
+func (g *Generator) loadPackage(patterns []string) (*Package, error) {
 	cfg := &packages.Config{
 		Mode:       packages.NeedName | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
 		Tests:      false,
-		BuildFlags: []string{fmt.Sprintf("-tags=%s", strings.Join(tags, " "))},
+		BuildFlags: []string{fmt.Sprintf("-tags=%s", strings.Join(g.tags, " "))},
 	}
 	pkgs, err := packages.Load(cfg, patterns...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(pkgs) != 1 {
-		return fmt.Errorf("expected one package matching patterns %s, got %d", strings.Join(patterns, " "), len(pkgs))
+		return nil, fmt.Errorf("expected one package matching patterns %s, got %d", strings.Join(patterns, " "), len(pkgs))
 	}
-	g.addPackage(pkgs[0])
 
-	return nil
+	return newPackage(pkgs[0]), nil
 }
 
-func (g *Generator) addPackage(pkg *packages.Package) {
-	g.pkg = &Package{
+func newPackage(pkg *packages.Package) *Package {
+	p := &Package{
 		name:  pkg.Name,
+		path:  pkg.PkgPath,
 		defs:  pkg.TypesInfo.Defs,
+		info:  pkg.TypesInfo,
+		fset:  pkg.Fset,
+		scope: pkg.Types.Scope(),
 		files: make([]*File, len(pkg.Syntax)),
 	}
 
 	for i, file := range pkg.Syntax {
-		g.pkg.files[i] = &File{
+		p.files[i] = &File{
 			file: file,
-			pkg:  g.pkg,
+			pkg:  p,
 		}
 	}
+
+	return p
+}
+
+// addImport records a package referenced by generated code for WritePreambleAndImports.
+func (g *Generator) addImport(importPath string, name string) {
+	if g.imports == nil {
+		g.imports = make(map[string]string)
+	}
+	g.imports[importPath] = name
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -139,35 +299,111 @@ func (g *Generator) WritePreambleAndImports(args []string) {
 	body := g.buf.String()
 
 	var s strings.Builder
-	_, _ = s.WriteString(fmt.Sprintf("// Code generated by \"go-enum-codegen %s\"; DO NOT EDIT.\n\n", strings.Join(args, " ")))
+	_, _ = s.WriteString(header(args))
 	_, _ = s.WriteString(fmt.Sprintf("package %s\n\n", g.pkg.name))
-	anySigned := cuts.AnyWhere(g.kinds, func(val ValueType) bool {
-		return val == TypeSigned
-	})
-	anyUnsigned := cuts.AnyWhere(g.kinds, func(val ValueType) bool {
-		return val == TypeUnsigned
+	_, _ = s.WriteString(importDecl(g.imports))
+	g.buf.Reset()
+	g.Printf("%s%s", s.String(), body)
+}
+
+// importDecl returns the import declaration of a generated file importing imports, a map of import path
+// to package name or "" when no import name is needed, with standard library packages grouped first.
+func importDecl(imports map[string]string) string {
+	var stdImports, otherImports []string
+	for importPath, name := range imports {
+		imp := strconv.Quote(importPath)
+		if name != "" && name != path.Base(importPath) {
+			imp = fmt.Sprintf("%s %q", name, importPath)
+		}
+		// standard library packages have no dot in their first path element
+		if first, _, _ := strings.Cut(importPath, "/"); name == "" && !strings.Contains(first, ".") {
+			stdImports = append(stdImports, imp)
+			continue
+		}
+		otherImports = append(otherImports, imp)
+	}
+	slices.Sort(stdImports)
+	slices.SortFunc(otherImports, func(a, b string) int {
+		return strings.Compare(a[strings.Index(a, "\""):], b[strings.Index(b, "\""):])
 	})
-	if g.doScanValue || anySigned || anyUnsigned {
+
+	var s strings.Builder
+	switch {
+	case len(stdImports) == 0 && len(otherImports) == 0:
+	case len(stdImports) == 1 && len(otherImports) == 0:
+		_, _ = s.WriteString(fmt.Sprintf("import %s\n\n", stdImports[0]))
+	default:
 		_, _ = s.WriteString("import (\n")
-		if g.doScanValue {
-			_, _ = s.WriteString("\t\"database/sql/driver\"\n")
+		for _, imp := range stdImports {
+			_, _ = s.WriteString(fmt.Sprintf("\t%s\n", imp))
 		}
-		_, _ = s.WriteString("\t\"fmt\"\n")
-		if (anySigned || anyUnsigned) && !g.useString && g.doJson {
-			_, _ = s.WriteString("\t\"strconv\"\n")
+		if len(stdImports) > 0 && len(otherImports) > 0 {
+			_, _ = s.WriteString("\n")
+		}
+		for _, imp := range otherImports {
+			_, _ = s.WriteString(fmt.Sprintf("\t%s\n", imp))
 		}
 		_, _ = s.WriteString(")\n\n")
-	} else {
-		_, _ = s.WriteString("import \"fmt\"\n\n")
 	}
-	g.buf.Reset()
-	g.Printf("%s%s", s.String(), body)
+	return s.String()
 }
 
 func (g *Generator) reset() {
 	g.hasUnset = false
 	g.isStringer = false
 	g.defaultValue = nil
+	g.explicitDefault = false
+	g.unset = nil
+	g.declared = nil
+	g.basicKind = types.Invalid
+	g.wroteString = false
+}
+
+// Enums returns the model of every type processed by Generate so far, in call order.
+func (g *Generator) Enums() []Enum {
+	return g.enums
+}
+
+// EnumTypes returns the names of the types of the parsed package that Generate accepts, in declaration order:
+// defined types with an integer, string, bool, or float underlying type and at least one constant.
+func (g *Generator) EnumTypes() []string {
+	scope := g.pkg.scope
+	var typeNames []*types.TypeName
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+		basic, ok := obj.Type().Underlying().(*types.Basic)
+		if !ok || basic.Info()&(types.IsInteger|types.IsString|types.IsBoolean|types.IsFloat) == 0 {
+			continue
+		}
+		if slices.ContainsFunc(scope.Names(), func(name string) bool {
+			c, ok := scope.Lookup(name).(*types.Const)
+			return ok && types.Identical(c.Type(), obj.Type())
+		}) {
+			typeNames = append(typeNames, obj)
+		}
+	}
+	slices.SortFunc(typeNames, func(a, b *types.TypeName) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+
+	names := make([]string, len(typeNames))
+	for i, obj := range typeNames {
+		names[i] = obj.Name()
+	}
+	return names
+}
+
+func (g *Generator) enum(typeName string) (Enum, error) {
+	for _, e := range g.enums {
+		if e.TypeName == typeName {
+			return e, nil
+		}
+	}
+
+	return Enum{}, fmt.Errorf("type %s has not been generated", typeName)
 }
 
 func (g *Generator) Format() ([]byte, error) {
@@ -182,34 +418,25 @@ func (g *Generator) Format() ([]byte, error) {
 func (g *Generator) Generate(typeName string) error {
 	g.reset()
 	g.logf("reset values for Generate run for type %s", typeName)
-	values := make([]Value, 0, 100)
-	for _, file := range g.pkg.files {
-		file.typeName = typeName
-		file.values = nil
-		file.isStringer = false
-		file.hasUnset = false
-		if file.file != nil {
-			g.logf("inspecting file %s", file.file.Name)
-			ast.Inspect(file.file, file.GenDecl)
-			values = append(values, file.values...)
-		}
-		if file.hasUnset {
-			g.hasUnset = true
-		}
-	}
+	values, hasUnset := g.collectValues(g.pkg, typeName)
+	g.hasUnset = hasUnset
 
 	if len(values) == 0 {
 		return fmt.Errorf("no values defined for type %s", typeName)
 	}
 	g.logf("detected %d values", len(values))
 
-	values = cuts.DedupeFunc(values, func(v Value) string {
-		return v.StrVal
-	})
+	explicitDefault, err := g.findExplicitDefault(typeName, values)
+	if err != nil {
+		return err
+	}
 
-	slices.SortStableFunc(values, func(a, b Value) int {
-		return cmp.Compare(a.StrVal, b.StrVal)
-	})
+	declared := make([]Value, 0, len(values))
+	for _, v := range values {
+		if !slices.ContainsFunc(declared, func(d Value) bool { return d.StrVal == v.StrVal }) {
+			declared = append(declared, v)
+		}
+	}
 
 	kind := values[0].ValType
 	recv := values[0].RecvName
@@ -217,195 +444,220 @@ func (g *Generator) Generate(typeName string) error {
 		recv = strings.ToLower(string(typeName[0]))
 	}
 	g.isStringer = values[0].IsStringer
+	g.basicKind = values[0].BasicKind
 	g.logf("data for type %s: kind: %s, receiver: %s, isStringer: %t", typeName, kind, recv, g.isStringer)
 
 	if (kind == TypeSigned || kind == TypeUnsigned) && g.useString && !g.isStringer {
 		return fmt.Errorf("type %s does not implement fmt.Stringer", typeName)
 	}
 
+	if g.checkString && g.useString && g.isStringer {
+		outputs, err := g.pkg.StringerOutputs(typeName, declared)
+		if err != nil {
+			return fmt.Errorf("unable to check String() of type %s: %w", typeName, err)
+		}
+		g.logf("String() outputs for type %s: %v", typeName, outputs)
+		if err := CheckStringerOutputs(typeName, declared, outputs); err != nil {
+			return err
+		}
+	}
+
 	g.kinds = append(g.kinds, kind)
+	g.declared = declared
 
-	var defaultValue Value
-	if kind == TypeString {
-		defaultValue = Value{StrVal: "\"\""}
-	} else {
-		defaultValue = Value{StrVal: "0"}
+	for _, v := range declared {
+		if !v.Unset {
+			continue
+		}
+		if g.unset != nil {
+			return fmt.Errorf("type %s has more than one constant annotated %s%s: %s, %s", typeName, annotationPrefix, AnnotationUnset, g.unset.Name, v.Name)
+		}
+		g.logf("detected unset value %#v", v)
+		g.unset = &v
 	}
 
-	index, exists := slices.BinarySearchFunc(values, defaultValue, func(a, b Value) int {
-		return cmp.Compare(a.StrVal, b.StrVal)
-	})
+	// unknown values fall back to the unset constant unless another one is chosen
+	if explicitDefault == nil {
+		explicitDefault = g.unset
+	}
+	defaultValue := Value{StrVal: kind.zero()}
+	if explicitDefault != nil {
+		defaultValue = *explicitDefault
+		g.explicitDefault = true
+	}
 
-	if exists {
-		v := values[index]
+	if index := slices.IndexFunc(declared, func(v Value) bool {
+		return v.StrVal == defaultValue.StrVal
+	}); index >= 0 {
+		v := declared[index]
+		if explicitDefault != nil {
+			// keep the chosen name when it is an alias of another constant
+			v = *explicitDefault
+		}
 		g.logf("detected default value %#v", v)
 		g.defaultValue = &v
-		if !g.errOnUnk {
-			g.logf("removing default value from value list")
-			values = slices.Delete(values, index, index+1)
-		}
+	}
+
+	var fallback *Value
+	if g.fallsBack() {
+		fallback = g.defaultValue
+	}
+	g.enums = append(g.enums, Enum{
+		TypeName:     typeName,
+		Kind:         kind,
+		RecvName:     recv,
+		IsStringer:   g.isStringer,
+		DefaultValue: fallback,
+		Unset:        g.unset,
+		Values:       declared,
+	})
+
+	if (kind == TypeSigned || kind == TypeUnsigned) && g.useString && g.isStringer {
+		g.logf("writing stringer lookup map")
+		g.Printf("%s", WriteStringerMap(declared, typeName))
 	}
 
 	if g.doScanValue {
 		g.logf("starting sql.Scanner & driver.Valuer run")
-		g.writeScannerValuer(recv, values, kind, typeName)
+		if err := g.writeTemplate(ScannerTemplate, g.templateData()); err != nil {
+			return err
+		}
 	}
 
-	if g.doJson {
+	if g.doJson && g.zeroAlloc {
+		g.logf("starting allocation-free json.Marshaler and json.Unmarshaler run")
+		if err := g.writeTemplate(ZeroAllocJSONTemplate, g.templateData()); err != nil {
+			return err
+		}
+	} else if g.doJson {
 		g.logf("starting json.Marshaler and json.Unmarshaler run")
-		g.writeMarshalerUnmarshaler(recv, values, kind, typeName)
+		if err := g.writeTemplate(JSONTemplate, g.templateData()); err != nil {
+			return err
+		}
 	}
 
-	return nil
-}
+	if g.doYaml {
+		g.logf("starting yaml.Marshaler and yaml.Unmarshaler run")
+		if err := g.writeTemplate(YAMLTemplate, g.templateData()); err != nil {
+			return err
+		}
+	}
 
-func (g *Generator) writeScannerValuer(recv string, values []Value, kind ValueType, typeName string) {
-	assgnVar, convType := g.getReadAssignVarAndConvType(kind)
-	g.logf("using assignment variable %s and will convert to type %s for Scan method", assgnVar, convType)
-	g.Printf("// Scan implements sql.Scanner for %s\n", typeName)
-	g.Printf("func (%s *%s) Scan(value interface{}) error {\n", recv, typeName)
-	g.writeScannerTypeAssertionStmnt("scan", assgnVar, convType, typeName)
-	g.logf("wrote type assertion statement")
-	g.writeReadCaseStatement(recv, values, kind, assgnVar, typeName)
-	g.logf("wrote case statement")
-	g.writeReadDefaultCase("scan", recv, assgnVar, typeName)
-	g.logf("wrote default case statement")
-	g.writeReadCloser()
-	g.Printf("// Value implements driver.Valuer for %s\n", typeName)
-	g.writeValuerBody(recv, kind, typeName)
-	g.logf("wrote Value method")
-}
-
-func (g *Generator) writeMarshalerUnmarshaler(recv string, values []Value, kind ValueType, typeName string) {
-	assgnVar, convType := g.getReadAssignVarAndConvType(kind)
-	g.logf("using assignment variable %s and will convert to type %s for UnmarshalJSON method", assgnVar, convType)
-	g.Printf("// UnmarshalJSON implements json.Unmarshaler for %s\n", typeName)
-	g.Printf("func (%s *%s) UnmarshalJSON(data []byte) error {\n", recv, typeName)
-	g.writeUnmarshalerTypeConversionStmnt(assgnVar, convType, "unmarshal", typeName)
-	g.logf("wrote type conversion statement")
-	g.writeReadCaseStatement(recv, values, kind, assgnVar, typeName)
-	g.logf("wrote case statement")
-	g.writeReadDefaultCase("unmarshal", recv, assgnVar, typeName)
-	g.logf("wrote default case statement")
-	g.writeReadCloser()
-	g.Printf("// MarshalJSON implements json.Marshaler for %s\n", typeName)
-	g.writeMarshalerBody(recv, convType, typeName)
-	g.logf("wrote MarshalJSON method")
-}
-
-func (g *Generator) writeMarshalerBody(recv string, convType string, typeName string) {
-	g.Printf("func (%s %s) MarshalJSON() ([]byte, error) {\n", recv, typeName)
-	g.Printf("\treturn []byte(")
-	switch {
-	case g.useString && g.isStringer:
-		g.Printf("%s.String()", recv)
-		g.logf("returning %s.String(), nil for MarshalJSON", typeName)
-	case convType == "string":
-		g.Printf("%s", recv)
-		g.logf("returning %s, nil for MarshalJSON", typeName)
-	default:
-		g.Printf("fmt.Sprintf(\"%%d\", %s(%s))", convType, recv)
-		g.logf("returning fmt.Sprintf'd %s, nil for MarshalJSON", typeName)
+	if g.doNull {
+		g.logf("starting %s run", NullTypeName(typeName))
+		if err := g.writeTemplate(NullTemplate, g.templateData()); err != nil {
+			return err
+		}
 	}
-	g.Printf("), nil\n")
-	g.Printf("}\n\n")
-}
 
-func (g *Generator) writeValuerBody(recv string, kind ValueType, typeName string) {
-	g.Printf("func (%s %s) Value() (driver.Value, error) {\n", recv, typeName)
-	var returnStmt strings.Builder
-	switch {
-	case g.useString && g.isStringer:
-		_, _ = returnStmt.WriteString(recv)
-		_, _ = returnStmt.WriteString(".String()")
-		g.logf("Valuer will return %s.String()", typeName)
-	case kind == TypeString:
-		_, _ = returnStmt.WriteString("string(")
-		_, _ = returnStmt.WriteString(recv)
-		_, _ = returnStmt.WriteString(")")
-		g.logf("Valuer will return string(%s)", typeName)
-	case kind == TypeSigned:
-		_, _ = returnStmt.WriteString("int(")
-		_, _ = returnStmt.WriteString(recv)
-		_, _ = returnStmt.WriteString(")")
-		g.logf("Valuer will return int(%s)", typeName)
-	default:
-		_, _ = returnStmt.WriteString("uint(")
-		_, _ = returnStmt.WriteString(recv)
-		_, _ = returnStmt.WriteString(")")
-		g.logf("Valuer will return uint(%s)", typeName)
+	if g.doFlag {
+		g.logf("starting flag.Value run")
+		if err := g.writeTemplate(FlagTemplate, g.templateData()); err != nil {
+			return err
+		}
+		g.wroteString = true
 	}
-	g.Printf("\treturn %s, nil\n", returnStmt.String())
-	g.Printf("}\n\n")
-}
 
-func (g *Generator) writeReadCloser() {
-	g.Printf("\t}\n")
-	g.Printf("\n")
-	g.Printf("\treturn nil\n")
-	g.Printf("}\n\n")
-}
+	if g.doDescribe {
+		g.logf("starting Description run")
+		if err := g.writeTemplate(DescriptionTemplate, g.templateData()); err != nil {
+			return err
+		}
+	}
 
-func (g *Generator) writeReadDefaultCase(method string, recv string, assgnVar string, typeName string) {
-	g.Printf("\tdefault:\n")
-	switch {
-	case !g.errOnUnk && g.defaultValue != nil && !g.hasUnset:
-		g.logf("writing default statement to assign to default value")
-		g.Printf("\t\t*%s = %s\n", recv, g.defaultValue.Name)
-	default:
-		g.logf("writing default statement to return error")
-		g.Printf("\t\treturn fmt.Errorf(\"failed to %s %s value: unrecognized value `%%v`\", %s)\n", method, typeName, assgnVar)
+	if g.doRuntime {
+		g.logf("starting enum.Enum run")
+		if err := g.writeTemplate(RuntimeTemplate, g.templateData()); err != nil {
+			return err
+		}
+		g.wroteString = true
 	}
-}
 
-func (g *Generator) writeReadCaseStatement(recv string, values []Value, kind ValueType, assgnVar string, typeName string) {
-	var stmnt string
-	switch {
-	case (kind == TypeSigned || kind == TypeUnsigned) && g.useString && g.isStringer:
-		g.logf("writing multi-case statement")
-		stmnt = WriteMultiCaseStatement(values, recv)
-	default:
-		g.logf("writing single case statement")
-		stmnt = WriteReadSingleCaseStatement(values, recv, assgnVar, typeName, kind)
+	if g.doTests {
+		g.logf("starting round trip test run")
+		g.writeTests(declared, kind, typeName)
+	}
+
+	if g.doBench {
+		g.logf("starting benchmark run")
+		g.writeBenchmarks(declared, kind, typeName)
+	}
 
+	if protoType, ok := g.protoBridges[typeName]; ok {
+		g.logf("starting protobuf bridge run for %s", protoType)
+		if err := g.writeProtoBridge(declared, typeName, protoType); err != nil {
+			return err
+		}
 	}
-	g.Printf(stmnt)
+
+	return nil
 }
 
-func (g *Generator) writeScannerTypeAssertionStmnt(method string, assgnVar string, convType string, typeName string) {
-	g.Printf("\t%s, ok := value.(%s)\n", assgnVar, convType)
-	g.Printf("\tif !ok {\n")
-	g.Printf("\t\treturn fmt.Errorf(\"failed to %s %s value: expected type `%s`, got `%%T`\", value)\n", method, typeName, convType)
-	g.Printf("\t}\n")
-	g.Printf("\tswitch %s {\n", assgnVar)
+func (g *Generator) collectValues(pkg *Package, typeName string) ([]Value, bool) {
+	values := make([]Value, 0, 100)
+	hasUnset := false
+	for _, file := range pkg.files {
+		file.typeName = typeName
+		file.values = nil
+		file.isStringer = false
+		file.hasUnset = false
+		if file.file != nil {
+			g.logf("inspecting file %s", file.file.Name)
+			ast.Inspect(file.file, file.GenDecl)
+			values = append(values, file.values...)
+		}
+		if file.hasUnset {
+			hasUnset = true
+		}
+	}
+
+	return values, hasUnset
+}
+
+// fallsBack reports whether readers assign unknown values the default value rather than return an error.
+// An explicitly chosen default overrides the strict mode implied by a blank zero slot.
+func (g *Generator) fallsBack() bool {
+	return !g.errOnUnk && g.defaultValue != nil && (g.explicitDefault || !g.hasUnset)
 }
 
-func (g *Generator) writeUnmarshalerTypeConversionStmnt(assgnVar string, convType string, method string, typeName string) {
-	g.Printf("\tstr := string(data)\n")
-	g.logf("converting []byte to string")
-	if convType == "int" {
-		g.Printf("\tv, err := strconv.ParseInt(str, 10, 64)\n")
-		g.logf("using strconv.ParseInt")
-	} else if convType == "uint" {
-		g.Printf("\tv, err := strconv.ParseUint(str, 10, 64)\n")
-		g.logf("using strconv.ParseUint")
+// findExplicitDefault returns the constant of typeName chosen with WithDefault, or else annotated "// enum:default", if any.
+func (g *Generator) findExplicitDefault(typeName string, values []Value) (*Value, error) {
+	if name, ok := g.defaultNames[typeName]; ok {
+		for _, v := range values {
+			if v.Name == name {
+				return &v, nil
+			}
+		}
+		return nil, fmt.Errorf("default value %s is not a constant of type %s", name, typeName)
 	}
-	if convType == "uint" || convType == "int" {
-		g.Printf("\tif err != nil {\n")
-		g.Printf("\t\treturn fmt.Errorf(\"failed to %s %s value: could not convert `[]byte` to `%s`: %%v\", err)\n", method, typeName, convType)
-		g.Printf("\t}\n")
-		g.Printf("\t%s := %s(v)\n", assgnVar, convType)
+
+	var found *Value
+	for _, v := range values {
+		if !v.Default {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("type %s has more than one constant annotated %s%s: %s, %s", typeName, annotationPrefix, AnnotationDefault, found.Name, v.Name)
+		}
+		found = &v
 	}
-	g.Printf("\tswitch %s {\n", assgnVar)
+
+	return found, nil
 }
 
 func (g *Generator) getReadAssignVarAndConvType(kind ValueType) (string, string) {
 	var assgnVar string
 	var t string
 	switch {
-	case kind == TypeString, g.useString && g.isStringer:
+	case kind == TypeString, kind == TypeRune, g.useString && g.isStringer:
 		t = "string"
 		assgnVar = "str"
+	case kind == TypeBool:
+		t = "bool"
+		assgnVar = "b"
+	case kind == TypeFloat:
+		t = "float64"
+		assgnVar = "f"
 	case kind == TypeSigned:
 		t = "int"
 		assgnVar = "i"
@@ -416,15 +668,31 @@ func (g *Generator) getReadAssignVarAndConvType(kind ValueType) (string, string)
 	return assgnVar, t
 }
 
+// parseBitSize returns the bitSize argument for strconv.ParseInt, strconv.ParseUint and strconv.ParseFloat
+// so that inputs outside the range of the underlying type are rejected.
+func (g *Generator) parseBitSize() int {
+	if size := BitSize(g.basicKind); size != 0 {
+		return size
+	}
+	return 64
+}
+
 func WriteReadSingleCaseStatement(values []Value, receiver string, assgnVar string, typeName string, kind ValueType) string {
 	var s strings.Builder
 	_, _ = s.WriteString("\tcase ")
 	var valValues []string
 	for _, value := range values {
 		var val string
-		if kind == TypeString {
+		switch kind {
+		case TypeString:
 			val = value.StrVal
-		} else {
+		case TypeRune:
+			lit, _ := value.Literal()
+			val = strconv.Quote(fmt.Sprint(lit))
+		case TypeFloat:
+			// compare against the constant itself so float32 values match after widening
+			val = fmt.Sprintf("float64(%s)", value.Name)
+		default:
 			val = strings.Trim(value.StrVal, `"`)
 		}
 		valValues = append(valValues, val)
@@ -432,10 +700,15 @@ func WriteReadSingleCaseStatement(values []Value, receiver string, assgnVar stri
 	vals := strings.Join(valValues, ", ")
 	_, _ = s.WriteString(vals)
 	_, _ = s.WriteString(":\n")
-	_, _ = s.WriteString(fmt.Sprintf("\t\t*%s = %s(%s)\n", receiver, typeName, assgnVar))
+	if kind == TypeRune {
+		_, _ = s.WriteString(fmt.Sprintf("\t\t*%s = %s([]rune(%s)[0])\n", receiver, typeName, assgnVar))
+	} else {
+		_, _ = s.WriteString(fmt.Sprintf("\t\t*%s = %s(%s)\n", receiver, typeName, assgnVar))
+	}
 	return s.String()
 }
 
+// Deprecated: generated code looks String() values up in the map written by WriteStringerMap instead.
 func WriteMultiCaseStatement(values []Value, receiver string) string {
 	var s strings.Builder
 	for _, value := range values {
@@ -444,3 +717,19 @@ func WriteMultiCaseStatement(values []Value, receiver string) string {
 	}
 	return s.String()
 }
+
+// StringerMapName returns the name of the package-level variable mapping String() outputs of typeName back to its constants.
+func StringerMapName(typeName string) string {
+	return fmt.Sprintf("_%s_byString", typeName)
+}
+
+func WriteStringerMap(values []Value, typeName string) string {
+	var s strings.Builder
+	_, _ = s.WriteString(fmt.Sprintf("// %s maps the String() of each %s constant back to the constant\n", StringerMapName(typeName), typeName))
+	_, _ = s.WriteString(fmt.Sprintf("var %s = map[string]%s{\n", StringerMapName(typeName), typeName))
+	for _, value := range values {
+		_, _ = s.WriteString(fmt.Sprintf("\t%s.String(): %s,\n", value.Name, value.Name))
+	}
+	_, _ = s.WriteString("}\n\n")
+	return s.String()
+}
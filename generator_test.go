@@ -1,7 +1,11 @@
 package goenumcodegen
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
+	"go/types"
+	"path/filepath"
+	"strconv"
 	"testing"
 )
 
@@ -192,3 +196,273 @@ func TestWriteMultiCaseStatement(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateDefault(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Type     string
+		Opts     []Opt
+		Default  string
+		Expected string
+	}{
+		{
+			Name:    "annotated default",
+			Type:    "Status",
+			Default: "StatusUnknown",
+		},
+		{
+			Name:    "default flag",
+			Type:    "Color",
+			Opts:    []Opt{WithDefault("Color", "ColorOther")},
+			Default: "ColorOther",
+		},
+		{
+			Name:    "default flag overrides annotation",
+			Type:    "Status",
+			Opts:    []Opt{WithDefault("Status", "StatusActive")},
+			Default: "StatusActive",
+		},
+		{
+			Name:     "unknown constant",
+			Type:     "Color",
+			Opts:     []Opt{WithDefault("Color", "ColorBlue")},
+			Expected: "default value ColorBlue is not a constant of type Color",
+		},
+		{
+			Name:     "constant of another type",
+			Type:     "Color",
+			Opts:     []Opt{WithDefault("Color", "StatusActive")},
+			Expected: "default value StatusActive is not a constant of type Color",
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			g := NewGenerator(tc.Opts...)
+			assert.NoError(t, g.ParsePackage([]string{"./examples/fallback"}, nil))
+			err := g.Generate(tc.Type)
+			if tc.Expected != "" {
+				assert.EqualError(t, err, tc.Expected)
+				return
+			}
+			assert.NoError(t, err)
+			e, err := g.enum(tc.Type)
+			assert.NoError(t, err)
+			if assert.NotNil(t, e.DefaultValue) {
+				assert.Equal(t, tc.Default, e.DefaultValue.Name)
+			}
+		})
+	}
+}
+
+func TestEnumTypes(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Dir      string
+		Expected []string
+	}{
+		{
+			Name:     "several types",
+			Dir:      "./examples/fallback",
+			Expected: []string{"Status", "Color"},
+		},
+		{
+			Name:     "every kind",
+			Dir:      "./examples/kinds",
+			Expected: []string{"MyRuneEnum", "MyBoolEnum", "MyFloatEnum"},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			g := NewGenerator()
+			assert.NoError(t, g.ParsePackage([]string{tc.Dir}, nil))
+			assert.Equal(t, tc.Expected, g.EnumTypes())
+		})
+	}
+}
+
+func TestEnumsJSON(t *testing.T) {
+	g := NewGenerator()
+	assert.NoError(t, g.ParsePackage([]string{"./examples/unset"}, nil))
+	assert.NoError(t, g.Generate("MyStatus"))
+
+	data, err := json.Marshal(g.Enums())
+	assert.NoError(t, err)
+
+	var model []struct {
+		Type     string `json:"type"`
+		Kind     string `json:"kind"`
+		Receiver string `json:"receiver"`
+		Unset    struct {
+			Name string `json:"name"`
+		} `json:"unset"`
+		Values []struct {
+			Name     string `json:"name"`
+			Literal  string `json:"literal"`
+			Value    any    `json:"value"`
+			Position struct {
+				Filename string `json:"filename"`
+				Line     int    `json:"line"`
+			} `json:"position"`
+		} `json:"values"`
+	}
+	assert.NoError(t, json.Unmarshal(data, &model))
+	if assert.Len(t, model, 1) {
+		e := model[0]
+		assert.Equal(t, "MyStatus", e.Type)
+		assert.Equal(t, "string", e.Kind)
+		assert.Equal(t, "m", e.Receiver)
+		assert.Equal(t, "MyStatusUnset", e.Unset.Name)
+		if assert.NotEmpty(t, e.Values) {
+			assert.Equal(t, "MyStatusUnset", e.Values[0].Name)
+			assert.Equal(t, `""`, e.Values[0].Literal)
+			assert.Equal(t, "", e.Values[0].Value)
+			assert.Equal(t, "myenum.go", filepath.Base(e.Values[0].Position.Filename))
+			assert.Equal(t, 16, e.Values[0].Position.Line)
+		}
+	}
+}
+
+func TestEnumsDefaultValue(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Dir      string
+		Type     string
+		Opts     []Opt
+		Expected string
+	}{
+		{
+			Name:     "zero value",
+			Dir:      "./examples/string",
+			Type:     "MyEnum",
+			Expected: "MyEnumEmpty",
+		},
+		{
+			Name: "error on unknown",
+			Dir:  "./examples/string",
+			Type: "MyEnum",
+			Opts: []Opt{WithErrorOnUnknown()},
+		},
+		{
+			Name: "blank zero slot",
+			Dir:  "./examples/unset",
+			Type: "MyEnum",
+		},
+		{
+			Name:     "unset annotation",
+			Dir:      "./examples/unset",
+			Type:     "MyStatus",
+			Expected: "MyStatusUnset",
+		},
+		{
+			Name:     "annotated default",
+			Dir:      "./examples/fallback",
+			Type:     "Status",
+			Expected: "StatusUnknown",
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			g := NewGenerator(tc.Opts...)
+			assert.NoError(t, g.ParsePackage([]string{tc.Dir}, nil))
+			assert.NoError(t, g.Generate(tc.Type))
+			if tc.Expected == "" {
+				assert.Nil(t, g.Enums()[0].DefaultValue)
+			} else if assert.NotNil(t, g.Enums()[0].DefaultValue) {
+				assert.Equal(t, tc.Expected, g.Enums()[0].DefaultValue.Name)
+			}
+		})
+	}
+}
+
+func TestUnknownInput(t *testing.T) {
+	values := func(kind ValueType, literals ...string) []Value {
+		vals := make([]Value, len(literals))
+		for i, lit := range literals {
+			vals[i] = Value{Name: "MyEnum" + strconv.Itoa(i), StrVal: lit, ValType: kind}
+		}
+		return vals
+	}
+	every := func(kind ValueType, from int, to int) []Value {
+		var literals []string
+		for n := from; n <= to; n++ {
+			literals = append(literals, strconv.Itoa(n))
+		}
+		return values(kind, literals...)
+	}
+	tt := []struct {
+		Name       string
+		Kind       ValueType
+		BasicKind  types.BasicKind
+		Input      []Value
+		Expected   string
+		ExpectedOk bool
+	}{
+		{
+			Name:       "signed integer below its maximum",
+			Kind:       TypeSigned,
+			BasicKind:  types.Int8,
+			Input:      values(TypeSigned, "0", "1"),
+			Expected:   "2",
+			ExpectedOk: true,
+		},
+		{
+			Name:       "signed integer declaring its maximum",
+			Kind:       TypeSigned,
+			BasicKind:  types.Int8,
+			Input:      values(TypeSigned, "-128", "0", "127"),
+			Expected:   "-127",
+			ExpectedOk: true,
+		},
+		{
+			Name:       "int64 declaring its maximum",
+			Kind:       TypeSigned,
+			BasicKind:  types.Int64,
+			Input:      values(TypeSigned, "9223372036854775807"),
+			Expected:   "-9223372036854775808",
+			ExpectedOk: true,
+		},
+		{
+			Name:      "every int8",
+			Kind:      TypeSigned,
+			BasicKind: types.Int8,
+			Input:     every(TypeSigned, -128, 127),
+		},
+		{
+			Name:       "unsigned integer declaring zero and its maximum",
+			Kind:       TypeUnsigned,
+			BasicKind:  types.Uint64,
+			Input:      values(TypeUnsigned, "0", "18446744073709551615"),
+			Expected:   "1",
+			ExpectedOk: true,
+		},
+		{
+			Name:      "every uint8",
+			Kind:      TypeUnsigned,
+			BasicKind: types.Uint8,
+			Input:     every(TypeUnsigned, 0, 255),
+		},
+		{
+			Name:      "both bools",
+			Kind:      TypeBool,
+			BasicKind: types.Bool,
+			Input:     values(TypeBool, "true", "false"),
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			g := NewGenerator()
+			g.basicKind = tc.BasicKind
+			actual, ok := g.unknownInput(tc.Input, tc.Kind)
+			assert.Equal(t, tc.ExpectedOk, ok)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
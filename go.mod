@@ -6,6 +6,7 @@ require (
 	github.com/ejfrick/cuts v0.0.3
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/tools v0.22.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -14,5 +15,4 @@ require (
 	golang.org/x/exp v0.0.0-20240604190554-fc45aab8b7f8 // indirect
 	golang.org/x/mod v0.18.0 // indirect
 	golang.org/x/sync v0.7.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,6 @@
+package goenumcodegen
+
+// NullTypeName returns the name of the nullable wrapper generated for typeName.
+func NullTypeName(typeName string) string {
+	return "Null" + typeName
+}
@@ -1,12 +1,74 @@
 package goenumcodegen
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
+	"strconv"
+	"strings"
 )
 
+// GeneratedPrefix is the leading text of every file written by this tool.
+const GeneratedPrefix = "// Code generated by \"go-enum-codegen"
+
+// header returns the comment starting a file written with args, followed by a blank line.
+// Arguments that go generate would split or unquote are quoted so the header can be parsed back like a directive.
+func header(args []string) string {
+	var s strings.Builder
+	_, _ = s.WriteString(GeneratedPrefix)
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"") {
+			arg = strconv.Quote(arg)
+		}
+		_, _ = s.WriteString(" " + arg)
+	}
+	return fmt.Sprintf("%s\"; DO NOT EDIT.\n\n", s.String())
+}
+
 type Package struct {
 	name  string
+	path  string
 	defs  map[*ast.Ident]types.Object
+	info  *types.Info
+	fset  *token.FileSet
+	scope *types.Scope
 	files []*File
 }
+
+// isGenerated reports whether pos falls inside a file previously written by go-enum-codegen.
+func (p *Package) isGenerated(pos token.Pos) bool {
+	for _, f := range p.files {
+		if f.file == nil || pos < f.file.FileStart || pos > f.file.FileEnd {
+			continue
+		}
+		return len(f.file.Comments) > 0 && strings.HasPrefix(f.file.Comments[0].List[0].Text, GeneratedPrefix)
+	}
+
+	return false
+}
+
+// hasStringMethod reports whether the named type declares a String() string method
+// outside of code generated by this tool.
+func (p *Package) hasStringMethod(typeName string) bool {
+	if p.scope == nil {
+		return false
+	}
+	obj, ok := p.scope.Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return false
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return false
+	}
+	for i := 0; i < named.NumMethods(); i++ {
+		m := named.Method(i)
+		sig := m.Type().(*types.Signature)
+		if m.Name() == "String" && sig.Params().Len() == 0 && sig.Results().Len() == 1 && sig.Results().At(0).Type().String() == "string" && !p.isGenerated(m.Pos()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,4 @@
+package goenumcodegen
+
+// RuntimeImportPath is the import path of the runtime package that WithRuntime registers types with.
+const RuntimeImportPath = "github.com/ejfrick/go-enum-codegen/enum"
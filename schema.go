@@ -0,0 +1,129 @@
+package goenumcodegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+type enumSchema struct {
+	Schema       string   `json:"$schema,omitempty" yaml:"-"`
+	Title        string   `json:"title" yaml:"title"`
+	Type         string   `json:"type" yaml:"type"`
+	Enum         []any    `json:"enum" yaml:"enum"`
+	VarNames     []string `json:"x-enum-varnames" yaml:"x-enum-varnames"`
+	Descriptions []string `json:"x-enum-descriptions,omitempty" yaml:"x-enum-descriptions,omitempty"`
+}
+
+// JSONSchema returns a JSON Schema document listing the serialized values of typeName.
+// Generate must have been called for typeName first.
+func (g *Generator) JSONSchema(typeName string) ([]byte, error) {
+	schema, err := g.schema(typeName)
+	if err != nil {
+		return nil, err
+	}
+	schema.Schema = jsonSchemaDraft
+
+	src, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(src, '\n'), nil
+}
+
+// OpenAPISchema returns an OpenAPI components snippet in YAML describing typeName.
+// Generate must have been called for typeName first.
+func (g *Generator) OpenAPISchema(typeName string) ([]byte, error) {
+	schema, err := g.schema(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := map[string]map[string]map[string]enumSchema{
+		"components": {
+			"schemas": {
+				typeName: schema,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *Generator) schema(typeName string) (enumSchema, error) {
+	e, err := g.enum(typeName)
+	if err != nil {
+		return enumSchema{}, err
+	}
+
+	wire, err := g.wireValues(e)
+	if err != nil {
+		return enumSchema{}, err
+	}
+
+	schema := enumSchema{
+		Title: typeName,
+		Type:  "integer",
+		Enum:  wire,
+	}
+	switch {
+	case e.Kind == TypeString, e.Kind == TypeRune, g.useString && e.IsStringer:
+		schema.Type = "string"
+	case e.Kind == TypeBool:
+		schema.Type = "boolean"
+	case e.Kind == TypeFloat:
+		schema.Type = "number"
+	}
+
+	hasDocs := false
+	for _, v := range e.Values {
+		schema.VarNames = append(schema.VarNames, v.Name)
+		schema.Descriptions = append(schema.Descriptions, v.Doc)
+		if v.Doc != "" {
+			hasDocs = true
+		}
+	}
+	if !hasDocs {
+		schema.Descriptions = nil
+	}
+
+	return schema, nil
+}
+
+// wireValues returns the serialized form of every value of e, as written by the generated MarshalJSON and Value methods.
+func (g *Generator) wireValues(e Enum) ([]any, error) {
+	wire := make([]any, 0, len(e.Values))
+	if g.useString && e.IsStringer {
+		outputs, err := g.pkg.StringerOutputs(e.TypeName, e.Values)
+		if err != nil {
+			return nil, fmt.Errorf("cannot determine the String() output of type %s statically: %w", e.TypeName, err)
+		}
+		for _, v := range e.Values {
+			wire = append(wire, outputs[v.Name])
+		}
+		return wire, nil
+	}
+
+	for _, v := range e.Values {
+		lit, err := v.Literal()
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for constant %s: %w", v.Name, err)
+		}
+		wire = append(wire, lit)
+	}
+
+	return wire, nil
+}
@@ -0,0 +1,387 @@
+package goenumcodegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// maxStringerDepth bounds recursive X.String() calls while interpreting a String method.
+const maxStringerDepth = 8
+
+// StringerOutputs statically evaluates the String method of typeName for each of values,
+// returning the results keyed by constant name. Only String methods built from switch and
+// if statements on the receiver, string constants, local variables, map lookups, and
+// String() calls on other constants of the type can be evaluated.
+func (p *Package) StringerOutputs(typeName string, values []Value) (map[string]string, error) {
+	fn := p.stringMethod(typeName)
+	if fn == nil {
+		return nil, fmt.Errorf("no String method declared for type %s", typeName)
+	}
+
+	consts := make(map[string]constant.Value, len(values))
+	for _, v := range values {
+		if c, ok := p.scope.Lookup(v.Name).(*types.Const); ok {
+			consts[v.Name] = c.Val()
+		}
+	}
+
+	e := &stringerEval{pkg: p, fn: fn, consts: consts}
+	if len(fn.Recv.List[0].Names) > 0 {
+		e.recvName = fn.Recv.List[0].Names[0].Name
+	}
+
+	outputs := make(map[string]string, len(values))
+	for _, v := range values {
+		val, ok := consts[v.Name]
+		if !ok {
+			return nil, fmt.Errorf("no value for constant %s", v.Name)
+		}
+		out, err := e.eval(val, 0)
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s.String(): %w", v.Name, err)
+		}
+		outputs[v.Name] = out
+	}
+
+	return outputs, nil
+}
+
+// CheckStringerOutputs returns an error describing every constant whose String() output is
+// empty or shared with another constant, since the generated parsers cannot tell those apart.
+func CheckStringerOutputs(typeName string, values []Value, outputs map[string]string) error {
+	var problems []string
+	byOutput := make(map[string][]string)
+	for _, v := range values {
+		out := outputs[v.Name]
+		if out == "" {
+			problems = append(problems, fmt.Sprintf("%s.String() is empty", v.Name))
+		}
+		byOutput[out] = append(byOutput[out], v.Name)
+	}
+	for _, v := range values {
+		names := byOutput[outputs[v.Name]]
+		if len(names) > 1 && names[0] == v.Name && outputs[v.Name] != "" {
+			problems = append(problems, fmt.Sprintf("%s all return %q from String()", strings.Join(names, ", "), outputs[v.Name]))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("String() of type %s cannot be parsed back unambiguously: %s", typeName, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func (p *Package) stringMethod(typeName string) *ast.FuncDecl {
+	for _, f := range p.files {
+		if f.file == nil || p.isGenerated(f.file.Pos()) {
+			continue
+		}
+		for _, decl := range f.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Name.Name != "String" || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Body == nil {
+				continue
+			}
+			recvType := fn.Recv.List[0].Type
+			if star, ok := recvType.(*ast.StarExpr); ok {
+				recvType = star.X
+			}
+			if ident, ok := recvType.(*ast.Ident); ok && ident.Name == typeName {
+				return fn
+			}
+		}
+	}
+
+	return nil
+}
+
+type stringerEval struct {
+	pkg      *Package
+	fn       *ast.FuncDecl
+	recvName string
+	consts   map[string]constant.Value
+}
+
+type stringerFrame struct {
+	recv   constant.Value
+	locals map[string]string
+	depth  int
+}
+
+func (e *stringerEval) eval(recv constant.Value, depth int) (string, error) {
+	if depth > maxStringerDepth {
+		return "", fmt.Errorf("String() recurses more than %d times", maxStringerDepth)
+	}
+	frame := &stringerFrame{recv: recv, locals: make(map[string]string), depth: depth}
+	out, done, err := e.execBlock(frame, e.fn.Body.List)
+	if err != nil {
+		return "", err
+	}
+	if !done {
+		return "", fmt.Errorf("String() does not return")
+	}
+	return out, nil
+}
+
+func (e *stringerEval) execBlock(frame *stringerFrame, stmts []ast.Stmt) (string, bool, error) {
+	for _, stmt := range stmts {
+		out, done, err := e.exec(frame, stmt)
+		if err != nil || done {
+			return out, done, err
+		}
+	}
+	return "", false, nil
+}
+
+func (e *stringerEval) exec(frame *stringerFrame, stmt ast.Stmt) (string, bool, error) {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt:
+		if len(stmt.Results) != 1 {
+			return "", false, fmt.Errorf("unsupported return at %s", e.pos(stmt))
+		}
+		out, err := e.expr(frame, stmt.Results[0])
+		return out, true, err
+	case *ast.BlockStmt:
+		return e.execBlock(frame, stmt.List)
+	case *ast.DeclStmt:
+		gen, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			return "", false, fmt.Errorf("unsupported declaration at %s", e.pos(stmt))
+		}
+		for _, spec := range gen.Specs {
+			vspec := spec.(*ast.ValueSpec)
+			for i, name := range vspec.Names {
+				frame.locals[name.Name] = ""
+				if i < len(vspec.Values) {
+					out, err := e.expr(frame, vspec.Values[i])
+					if err != nil {
+						return "", false, err
+					}
+					frame.locals[name.Name] = out
+				}
+			}
+		}
+		return "", false, nil
+	case *ast.AssignStmt:
+		if len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 || (stmt.Tok != token.ASSIGN && stmt.Tok != token.DEFINE) {
+			return "", false, fmt.Errorf("unsupported assignment at %s", e.pos(stmt))
+		}
+		ident, ok := stmt.Lhs[0].(*ast.Ident)
+		if !ok {
+			return "", false, fmt.Errorf("unsupported assignment at %s", e.pos(stmt))
+		}
+		out, err := e.expr(frame, stmt.Rhs[0])
+		if err != nil {
+			return "", false, err
+		}
+		frame.locals[ident.Name] = out
+		return "", false, nil
+	case *ast.IfStmt:
+		if stmt.Init != nil {
+			return "", false, fmt.Errorf("unsupported if statement at %s", e.pos(stmt))
+		}
+		ok, err := e.cond(frame, stmt.Cond)
+		if err != nil {
+			return "", false, err
+		}
+		if ok {
+			return e.execBlock(frame, stmt.Body.List)
+		}
+		if stmt.Else != nil {
+			return e.exec(frame, stmt.Else)
+		}
+		return "", false, nil
+	case *ast.SwitchStmt:
+		if stmt.Init != nil {
+			return "", false, fmt.Errorf("unsupported switch statement at %s", e.pos(stmt))
+		}
+		var matched, def *ast.CaseClause
+		for _, s := range stmt.Body.List {
+			clause := s.(*ast.CaseClause)
+			if clause.List == nil {
+				def = clause
+				continue
+			}
+			for _, c := range clause.List {
+				var ok bool
+				var err error
+				if stmt.Tag == nil {
+					ok, err = e.cond(frame, c)
+				} else {
+					ok, err = e.equal(frame, stmt.Tag, c)
+				}
+				if err != nil {
+					return "", false, err
+				}
+				if ok && matched == nil {
+					matched = clause
+				}
+			}
+		}
+		if matched == nil {
+			matched = def
+		}
+		if matched == nil {
+			return "", false, nil
+		}
+		if n := len(matched.Body); n > 0 {
+			if branch, ok := matched.Body[n-1].(*ast.BranchStmt); ok && branch.Tok == token.FALLTHROUGH {
+				return "", false, fmt.Errorf("unsupported fallthrough at %s", e.pos(branch))
+			}
+		}
+		return e.execBlock(frame, matched.Body)
+	default:
+		return "", false, fmt.Errorf("unsupported statement at %s", e.pos(stmt))
+	}
+}
+
+// cond evaluates a comparison of the receiver against a constant.
+func (e *stringerEval) cond(frame *stringerFrame, expr ast.Expr) (bool, error) {
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.BinaryExpr:
+		switch expr.Op {
+		case token.EQL:
+			return e.equal(frame, expr.X, expr.Y)
+		case token.NEQ:
+			ok, err := e.equal(frame, expr.X, expr.Y)
+			return !ok, err
+		case token.LOR, token.LAND:
+			x, err := e.cond(frame, expr.X)
+			if err != nil {
+				return false, err
+			}
+			y, err := e.cond(frame, expr.Y)
+			if err != nil {
+				return false, err
+			}
+			if expr.Op == token.LOR {
+				return x || y, nil
+			}
+			return x && y, nil
+		}
+	}
+	return false, fmt.Errorf("unsupported condition at %s", e.pos(expr))
+}
+
+func (e *stringerEval) equal(frame *stringerFrame, x ast.Expr, y ast.Expr) (bool, error) {
+	xv, err := e.value(frame, x)
+	if err != nil {
+		return false, err
+	}
+	yv, err := e.value(frame, y)
+	if err != nil {
+		return false, err
+	}
+	return constant.Compare(xv, token.EQL, yv), nil
+}
+
+// value evaluates the receiver or a constant expression.
+func (e *stringerEval) value(frame *stringerFrame, expr ast.Expr) (constant.Value, error) {
+	expr = ast.Unparen(expr)
+	if ident, ok := expr.(*ast.Ident); ok && ident.Name == e.recvName {
+		return frame.recv, nil
+	}
+	if tv, ok := e.pkg.info.Types[expr]; ok && tv.Value != nil {
+		return tv.Value, nil
+	}
+	return nil, fmt.Errorf("unsupported operand at %s", e.pos(expr))
+}
+
+// expr evaluates a string-valued expression.
+func (e *stringerEval) expr(frame *stringerFrame, expr ast.Expr) (string, error) {
+	expr = ast.Unparen(expr)
+	if tv, ok := e.pkg.info.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return constant.StringVal(tv.Value), nil
+	}
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if out, ok := frame.locals[expr.Name]; ok {
+			return out, nil
+		}
+	case *ast.BinaryExpr:
+		if expr.Op == token.ADD {
+			x, err := e.expr(frame, expr.X)
+			if err != nil {
+				return "", err
+			}
+			y, err := e.expr(frame, expr.Y)
+			if err != nil {
+				return "", err
+			}
+			return x + y, nil
+		}
+	case *ast.CallExpr:
+		sel, ok := expr.Fun.(*ast.SelectorExpr)
+		if ok && sel.Sel.Name == "String" && len(expr.Args) == 0 {
+			val, err := e.value(frame, sel.X)
+			if err != nil {
+				return "", err
+			}
+			return e.eval(val, frame.depth+1)
+		}
+	case *ast.IndexExpr:
+		lit := e.mapLiteral(expr.X)
+		if lit == nil {
+			break
+		}
+		key, err := e.value(frame, expr.Index)
+		if err != nil {
+			return "", err
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			k, err := e.value(frame, kv.Key)
+			if err != nil {
+				return "", err
+			}
+			if constant.Compare(k, token.EQL, key) {
+				return e.expr(frame, kv.Value)
+			}
+		}
+		// a missing key yields the zero value
+		return "", nil
+	}
+	return "", fmt.Errorf("unsupported expression at %s", e.pos(expr))
+}
+
+// mapLiteral resolves expr to a map composite literal, either inline or assigned to a package-level variable.
+func (e *stringerEval) mapLiteral(expr ast.Expr) *ast.CompositeLit {
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.CompositeLit:
+		if _, ok := expr.Type.(*ast.MapType); ok {
+			return expr
+		}
+	case *ast.Ident:
+		obj := e.pkg.info.Uses[expr]
+		if obj == nil || obj.Parent() != e.pkg.scope {
+			return nil
+		}
+		var lit *ast.CompositeLit
+		for _, f := range e.pkg.files {
+			ast.Inspect(f.file, func(node ast.Node) bool {
+				vspec, ok := node.(*ast.ValueSpec)
+				if !ok {
+					return lit == nil
+				}
+				for i, name := range vspec.Names {
+					if e.pkg.defs[name] == obj && i < len(vspec.Values) {
+						lit = e.mapLiteral(vspec.Values[i])
+					}
+				}
+				return false
+			})
+		}
+		return lit
+	}
+	return nil
+}
+
+func (e *stringerEval) pos(node ast.Node) string {
+	return e.pkg.fset.Position(node.Pos()).String()
+}
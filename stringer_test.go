@@ -0,0 +1,156 @@
+package goenumcodegen
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+// stringerPackage type-checks a package declaring type T, its constants A, B, and C,
+// and the declarations in src.
+func stringerPackage(t *testing.T, src string) *Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "x.go", "package x\n\ntype T int\n\nconst (\n\tA T = iota\n\tB\n\tC\n)\n\n"+src, parser.ParseComments)
+	require.NoError(t, err)
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	pkg, err := new(types.Config).Check("x", fset, []*ast.File{file}, info)
+	require.NoError(t, err)
+	p := &Package{name: "x", path: "x", defs: info.Defs, info: info, fset: fset, scope: pkg.Scope()}
+	p.files = []*File{{file: file, pkg: p}}
+	return p
+}
+
+func TestStringerOutputs(t *testing.T) {
+	values := []Value{{Name: "A"}, {Name: "B"}, {Name: "C"}}
+	tt := []struct {
+		Name     string
+		Src      string
+		Expected map[string]string
+		// substring of the error of StringerOutputs
+		ExpectedErr string
+		// error of CheckStringerOutputs on the outputs
+		ExpectedCheckErr string
+	}{
+		{
+			Name:             "switch on the receiver",
+			Src:              "func (t T) String() string {\n\tswitch t {\n\tcase A:\n\t\treturn \"a\"\n\tcase B, C:\n\t\treturn \"b or c\"\n\t}\n\treturn \"\"\n}\n",
+			Expected:         map[string]string{"A": "a", "B": "b or c", "C": "b or c"},
+			ExpectedCheckErr: "String() of type T cannot be parsed back unambiguously: B, C all return \"b or c\" from String()",
+		},
+		{
+			Name:             "tagless switch with default",
+			Src:              "func (t T) String() string {\n\tswitch {\n\tcase t == A:\n\t\treturn \"a\"\n\tdefault:\n\t\treturn \"other\" + \"?\"\n\t}\n}\n",
+			Expected:         map[string]string{"A": "a", "B": "other?", "C": "other?"},
+			ExpectedCheckErr: "String() of type T cannot be parsed back unambiguously: B, C all return \"other?\" from String()",
+		},
+		{
+			Name:     "if else chain with a local variable",
+			Src:      "func (t T) String() string {\n\tvar s string\n\tif t == A {\n\t\ts = \"a\"\n\t} else if t != C {\n\t\ts = \"b\"\n\t} else {\n\t\ts = \"c\"\n\t}\n\treturn \"t:\" + s\n}\n",
+			Expected: map[string]string{"A": "t:a", "B": "t:b", "C": "t:c"},
+		},
+		{
+			Name:             "constant return",
+			Src:              "const name = \"t\"\n\nfunc (T) String() string {\n\treturn name\n}\n",
+			Expected:         map[string]string{"A": "t", "B": "t", "C": "t"},
+			ExpectedCheckErr: "String() of type T cannot be parsed back unambiguously: A, B, C all return \"t\" from String()",
+		},
+		{
+			Name:             "map lookup",
+			Src:              "var names = map[T]string{A: \"a\", B: \"b\"}\n\nfunc (t T) String() string {\n\treturn names[t]\n}\n",
+			Expected:         map[string]string{"A": "a", "B": "b", "C": ""},
+			ExpectedCheckErr: "String() of type T cannot be parsed back unambiguously: C.String() is empty",
+		},
+		{
+			Name:     "String of another constant",
+			Src:      "func (t T) String() string {\n\tif t == C {\n\t\treturn A.String() + \"!\"\n\t}\n\treturn map[T]string{A: \"a\", B: \"b\"}[t]\n}\n",
+			Expected: map[string]string{"A": "a", "B": "b", "C": "a!"},
+		},
+		{
+			Name:        "unsupported statement",
+			Src:         "func (t T) String() string {\n\tfor {\n\t\treturn \"a\"\n\t}\n}\n",
+			ExpectedErr: "cannot interpret A.String(): unsupported statement at x.go:12:2",
+		},
+		{
+			Name:        "unsupported expression",
+			Src:         "func name(t T) string {\n\treturn \"a\"\n}\n\nfunc (t T) String() string {\n\treturn name(t)\n}\n",
+			ExpectedErr: "cannot interpret A.String(): unsupported expression at x.go:16:9",
+		},
+		{
+			Name:        "unbounded recursion",
+			Src:         "func (t T) String() string {\n\treturn t.String()\n}\n",
+			ExpectedErr: "cannot interpret A.String(): String() recurses more than 8 times",
+		},
+		{
+			Name:        "no String method",
+			ExpectedErr: "no String method declared for type T",
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			outputs, err := stringerPackage(t, tc.Src).StringerOutputs("T", values)
+			if tc.ExpectedErr != "" {
+				assert.EqualError(t, err, tc.ExpectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, outputs)
+			err = CheckStringerOutputs("T", values, outputs)
+			if tc.ExpectedCheckErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.ExpectedCheckErr)
+			}
+		})
+	}
+}
+
+func TestCheckStringerOutputs(t *testing.T) {
+	values := []Value{
+		{Name: "MyEnumZero"},
+		{Name: "MyEnumOne"},
+		{Name: "MyEnumTwo"},
+	}
+	tt := []struct {
+		Name     string
+		Outputs  map[string]string
+		Expected string
+	}{
+		{
+			Name:    "distinct outputs",
+			Outputs: map[string]string{"MyEnumZero": "zero", "MyEnumOne": "one", "MyEnumTwo": "two"},
+		},
+		{
+			Name:     "duplicate outputs",
+			Outputs:  map[string]string{"MyEnumZero": "zero", "MyEnumOne": "one", "MyEnumTwo": "one"},
+			Expected: "String() of type MyEnum cannot be parsed back unambiguously: MyEnumOne, MyEnumTwo all return \"one\" from String()",
+		},
+		{
+			Name:     "empty output",
+			Outputs:  map[string]string{"MyEnumZero": "", "MyEnumOne": "one", "MyEnumTwo": "two"},
+			Expected: "String() of type MyEnum cannot be parsed back unambiguously: MyEnumZero.String() is empty",
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			err := CheckStringerOutputs("MyEnum", values, tc.Outputs)
+			if tc.Expected == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package goenumcodegen
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+const (
+	// ScannerTemplate renders the Scan and Value methods.
+	ScannerTemplate = "scanner.tmpl"
+	// JSONTemplate renders the UnmarshalJSON and MarshalJSON methods, unless WithZeroAllocJSON is set.
+	JSONTemplate = "json.tmpl"
+	// ZeroAllocJSONTemplate renders the UnmarshalJSON and MarshalJSON methods if WithZeroAllocJSON is set.
+	ZeroAllocJSONTemplate = "zeroalloc.tmpl"
+	// YAMLTemplate renders the UnmarshalYAML and MarshalYAML methods.
+	YAMLTemplate = "yaml.tmpl"
+	// FlagTemplate renders the Set method, the Type method for pflag, and a String method if the type has none.
+	FlagTemplate = "flag.tmpl"
+	// NullTemplate renders the nullable wrapper type and its methods.
+	NullTemplate = "null.tmpl"
+	// RuntimeTemplate renders the Values and IsValid methods, a String method if the type has none,
+	// and the registration with the runtime package.
+	RuntimeTemplate = "runtime.tmpl"
+	// DescriptionTemplate renders the table of doc comments and the Description method.
+	DescriptionTemplate = "describe.tmpl"
+	// ProtoBridgeTemplate renders the ToProto method and the FromProto function.
+	ProtoBridgeTemplate = "protobridge.tmpl"
+	// CommonTemplate defines the templates shared by the others, e.g. the switch of every reader.
+	// It is never rendered on its own.
+	CommonTemplate = "common.tmpl"
+)
+
+// TemplateData is the data model passed to every template.
+type TemplateData struct {
+	Enum
+	// BasicType is the exact underlying type, e.g. "int8" for a Kind of "int".
+	BasicType string
+	// BitSize is the bitSize argument of strconv.ParseInt, ParseUint and ParseFloat rejecting inputs outside BasicType.
+	BitSize int
+	// MinValue and MaxValue are the math constants bounding BasicType if it is an integer type narrower than 64 bits,
+	// e.g. "math.MinInt8" and "math.MaxInt8". MinValue is "" for unsigned types and both are "" for other types.
+	MinValue string
+	MaxValue string
+	// ReadType is the type readers convert their input to before matching it: string, bool, float64, int or uint.
+	ReadType string
+	// ReadVar is the name of the variable readers hold the converted input in.
+	ReadVar string
+	// Options holds the generator options that change the generated methods.
+	Options TemplateOptions
+	// Proto is the protobuf enum rendered by ProtoBridgeTemplate, and nil for the other templates.
+	Proto *ProtoData
+}
+
+// TemplateOptions holds the generator options that change the generated methods.
+type TemplateOptions struct {
+	// FallsBack is true if readers assign Enum.DefaultValue to unknown inputs instead of returning an error.
+	FallsBack bool
+	// UseStringer is true if values are read and written with the String method of the type.
+	UseStringer bool
+	// TypedErrors is true if readers return *enum.UnknownValueError for unknown inputs.
+	TypedErrors bool
+	// SQL and JSON are true if the Scan and Value, and the UnmarshalJSON and MarshalJSON methods are generated.
+	SQL  bool
+	JSON bool
+	// Pflag is true if the Type method of pflag.Value is generated.
+	Pflag bool
+	// NeedsString is true if the type has no String method yet, so FlagTemplate and RuntimeTemplate write one.
+	NeedsString bool
+}
+
+// ProtoData is the protobuf enum a type is bridged to.
+type ProtoData struct {
+	ImportPath string
+	// Package is the name of the package at ImportPath.
+	Package  string
+	TypeName string
+	// Pairs holds every constant of the type along with its protobuf counterpart.
+	Pairs []ProtoPair
+}
+
+// ProtoPair is a constant of a type and the protobuf constant it is converted to.
+type ProtoPair struct {
+	Value Value
+	Proto Value
+}
+
+// ReadValues returns the constants readers match, every constant but Enum.DefaultValue.
+func (d TemplateData) ReadValues() []Value {
+	if d.DefaultValue == nil {
+		return d.Values
+	}
+	return slices.DeleteFunc(slices.Clone(d.Values), func(v Value) bool {
+		return v.StrVal == d.DefaultValue.StrVal
+	})
+}
+
+// Reader returns the data of the reader method op, e.g. "scan", holding the converted input in the variable input
+// and called with the argument raw, for the templates shared by every reader.
+func (d TemplateData) Reader(op string, input string, raw string) ReaderData {
+	return ReaderData{TemplateData: d, Op: op, Input: input, Raw: raw}
+}
+
+// ReaderData is the data model of the templates shared by the readers, returned by TemplateData.Reader.
+type ReaderData struct {
+	TemplateData
+	// Op names the reader in error messages, e.g. "scan" or "unmarshal".
+	Op string
+	// Input is the expression holding the input converted to ReadType.
+	Input string
+	// Raw is the expression holding the input as the caller passed it.
+	Raw string
+}
+
+// WithTemplateDir renders generated methods with the templates in dir, named after the built-in templates
+// such as ScannerTemplate or JSONTemplate, in place of the built-in ones. Templates not found in dir keep their built-in version.
+func WithTemplateDir(dir string) Opt {
+	return func(g *Generator) {
+		g.templateDir = dir
+	}
+}
+
+// loadTemplates parses the built-in templates, then the overrides in the template directory, if any.
+func (g *Generator) loadTemplates() (*template.Template, error) {
+	if g.templates != nil {
+		return g.templates, nil
+	}
+	funcs := template.FuncMap{
+		// import adds a package to the imports of the generated file, e.g. {{import "log"}},
+		// optionally under a name, e.g. {{import "example.com/pb/v2" "pb"}}
+		"import": func(importPath string, name ...string) (string, error) {
+			switch len(name) {
+			case 0:
+				g.addImport(importPath, "")
+			case 1:
+				g.addImport(importPath, name[0])
+			default:
+				return "", fmt.Errorf("import %s: expected at most one package name, got %d", importPath, len(name))
+			}
+			return "", nil
+		},
+		"quote":       strconv.Quote,
+		"jsonLiteral": jsonLiteral,
+		// singleLine joins the lines of a doc comment
+		"singleLine": func(s string) string {
+			return strings.Join(strings.Fields(s), " ")
+		},
+		// wireValues returns the serialized form of each constant, or nil if it cannot be determined statically
+		"wireValues": func(e Enum) []any {
+			wire, err := g.wireValues(e)
+			if err != nil {
+				g.logf("omitting wire forms: %v", err)
+				return nil
+			}
+			return wire
+		},
+		"stringerMap":      StringerMapName,
+		"jsonTable":        JSONTableName,
+		"descriptionTable": DescriptionTableName,
+		"nullType":         NullTypeName,
+	}
+	tmpl, err := template.New("").Funcs(funcs).Option("missingkey=error").ParseFS(builtinTemplates, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	if g.templateDir != "" {
+		names, err := filepath.Glob(filepath.Join(g.templateDir, "*.tmpl"))
+		if err != nil {
+			return nil, err
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no templates in %s", g.templateDir)
+		}
+		builtin, err := fs.Glob(builtinTemplates, "templates/*.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		for i, name := range builtin {
+			builtin[i] = filepath.Base(name)
+		}
+		for _, name := range names {
+			if !slices.Contains(builtin, filepath.Base(name)) {
+				return nil, fmt.Errorf("unknown template %s: expected one of %s", name, strings.Join(builtin, ", "))
+			}
+			src, err := os.ReadFile(name)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := tmpl.New(filepath.Base(name)).Parse(string(src)); err != nil {
+				return nil, err
+			}
+		}
+		g.logf("loaded templates from %s", g.templateDir)
+	}
+
+	g.templates = tmpl
+	return tmpl, nil
+}
+
+// templateData returns the data model of the last type passed to Generate.
+func (g *Generator) templateData() TemplateData {
+	e := g.enums[len(g.enums)-1]
+	readVar, readType := g.getReadAssignVarAndConvType(e.Kind)
+	minValue, maxValue, _ := rangeBounds(g.basicKind)
+	return TemplateData{
+		Enum:      e,
+		BasicType: types.Typ[g.basicKind].Name(),
+		BitSize:   g.parseBitSize(),
+		MinValue:  minValue,
+		MaxValue:  maxValue,
+		ReadType:  readType,
+		ReadVar:   readVar,
+		Options: TemplateOptions{
+			FallsBack:   g.fallsBack(),
+			UseStringer: g.useString && g.isStringer,
+			TypedErrors: g.typedErrors,
+			SQL:         g.doScanValue,
+			JSON:        g.doJson,
+			Pflag:       g.doPflag,
+			NeedsString: !g.wroteString && !g.pkg.hasStringMethod(e.TypeName),
+		},
+	}
+}
+
+// writeTemplate renders the template name with data. Templates add the imports they need with {{import}}.
+func (g *Generator) writeTemplate(name string, data TemplateData) error {
+	tmpl, err := g.loadTemplates()
+	if err != nil {
+		return fmt.Errorf("error loading templates: %w", err)
+	}
+	var out bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&out, name, data); err != nil {
+		return fmt.Errorf("error rendering template %s: %w", name, err)
+	}
+	_, _ = g.buf.Write(out.Bytes())
+	g.logf("rendered template %s", name)
+	return nil
+}
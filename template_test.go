@@ -0,0 +1,83 @@
+package goenumcodegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateDir(t *testing.T) {
+	tt := []struct {
+		Name      string
+		Opts      []Opt
+		Templates map[string]string
+		Contains  string
+		// NotContains is absent from the output, e.g. an import only the replaced built-in template adds
+		NotContains string
+		Expected    string
+	}{
+		{
+			Name: "override wraps built-in body",
+			Templates: map[string]string{
+				JSONTemplate: "{{import \"fmt\"}}func ({{.RecvName}} *{{.TypeName}}) UnmarshalJSON(data []byte) error {\n{{template \"unmarshalJSONBody\" .}}}\n\n" +
+					"func ({{.RecvName}} {{.TypeName}}) MarshalJSON() ([]byte, error) {\n\treturn []byte(fmt.Sprint({{len .Values}})), nil\n}\n\n",
+			},
+			Contains:    "return []byte(fmt.Sprint(4)), nil",
+			NotContains: `"strconv"`,
+		},
+		{
+			Name: "override renders from the model",
+			Opts: []Opt{WithYamlMethods()},
+			Templates: map[string]string{
+				YAMLTemplate: "{{import \"gopkg.in/yaml.v3\"}}func ({{.RecvName}} *{{.TypeName}}) UnmarshalYAML(node *yaml.Node) error {\n\tswitch node.Value {\n" +
+					"{{range .Values}}\tcase {{.StrVal}}:\n\t\t*{{$.RecvName}} = {{.Name}}\n{{end}}\t}\n\treturn nil\n}\n\n",
+			},
+			Contains:    "case \"Three\":\n\t\t*m = MyEnumThree",
+			NotContains: "MarshalYAML",
+		},
+		{
+			Name:      "unknown template",
+			Templates: map[string]string{"enum.tmpl": ""},
+			Expected:  "unknown template",
+		},
+		{
+			Name:      "parse error",
+			Templates: map[string]string{ScannerTemplate: "{{.TypeName"},
+			Expected:  "error loading templates",
+		},
+		{
+			Name:      "unknown field",
+			Templates: map[string]string{ScannerTemplate: "{{.Nope}}"},
+			Expected:  "error rendering template scanner.tmpl",
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, src := range tc.Templates {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), 0644))
+			}
+			g := NewGenerator(append(tc.Opts, WithTemplateDir(dir))...)
+			require.NoError(t, g.ParsePackage([]string{"./examples/string"}, nil))
+			err := g.Generate("MyEnum")
+			if tc.Expected != "" {
+				assert.ErrorContains(t, err, tc.Expected)
+				return
+			}
+			require.NoError(t, err)
+			g.WritePreambleAndImports([]string{"-type", "MyEnum"})
+			src, err := g.Format()
+			require.NoError(t, err)
+			assert.Contains(t, string(src), tc.Contains)
+			if tc.NotContains != "" {
+				assert.NotContains(t, string(src), tc.NotContains)
+			}
+			assert.Contains(t, string(src), "// Scan implements sql.Scanner for MyEnum")
+		})
+	}
+}
@@ -0,0 +1,423 @@
+package goenumcodegen
+
+import (
+	"cmp"
+	"fmt"
+	"go/format"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func (g *Generator) testPrintf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(&g.testBuf, format, args...)
+}
+
+// addTestImport registers a package referenced by the generated tests.
+func (g *Generator) addTestImport(importPath string) {
+	if g.testImports == nil {
+		g.testImports = make(map[string]string)
+	}
+	g.testImports[importPath] = ""
+}
+
+// FormatTests returns the formatted round-trip tests and benchmarks generated for every type,
+// or nil if neither WithTests nor WithBenchmarks was set.
+func (g *Generator) FormatTests(args []string) ([]byte, error) {
+	if !g.doTests && !g.doBench {
+		return nil, nil
+	}
+
+	var s strings.Builder
+	_, _ = s.WriteString(header(args))
+	_, _ = s.WriteString(fmt.Sprintf("package %s\n\n", g.pkg.name))
+	imports := map[string]string{"testing": ""}
+	maps.Copy(imports, g.testImports)
+	_, _ = s.WriteString(importDecl(imports))
+	_, _ = s.WriteString(g.testBuf.String())
+	g.logf("Unformatted tests:\n%s", s.String())
+
+	return format.Source([]byte(s.String()))
+}
+
+func (g *Generator) writeTests(values []Value, kind ValueType, typeName string) {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.Name
+	}
+	all := strings.Join(names, ", ")
+
+	if g.doJson {
+		g.addTestImport("encoding/json")
+		g.testPrintf("func Test%sJSONRoundTrip(t *testing.T) {\n", typeName)
+		g.testPrintf("\tfor _, want := range []%s{%s} {\n", typeName, all)
+		g.testPrintf("\t\tdata, err := json.Marshal(want)\n")
+		g.testPrintf("\t\tif err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"json.Marshal(%%v): %%v\", want, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tvar got %s\n", typeName)
+		g.testPrintf("\t\tif err := json.Unmarshal(data, &got); err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"json.Unmarshal(%%s): %%v\", data, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tif got != want {\n")
+		g.testPrintf("\t\t\tt.Errorf(\"JSON round trip of %%v: got %%v\", want, got)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("}\n\n")
+		g.logf("wrote JSON round trip test")
+
+		if unknown, ok := g.unknownInput(values, kind); ok {
+			if g.jsonQuoted(kind) {
+				unknown = strconv.Quote(unknown)
+			}
+			g.testPrintf("func Test%sJSONUnknown(t *testing.T) {\n", typeName)
+			g.testPrintf("\tvar got %s\n", typeName)
+			g.testPrintf("\terr := json.Unmarshal([]byte(%s), &got)\n", strconv.Quote(unknown))
+			g.writeUnknownAssertion("json.Unmarshal")
+			g.testPrintf("}\n\n")
+			g.logf("wrote JSON unknown value test")
+		}
+	}
+
+	if g.doYaml {
+		g.addTestImport("gopkg.in/yaml.v3")
+		g.testPrintf("func Test%sYAMLRoundTrip(t *testing.T) {\n", typeName)
+		g.testPrintf("\tfor _, want := range []%s{%s} {\n", typeName, all)
+		g.testPrintf("\t\tdata, err := yaml.Marshal(want)\n")
+		g.testPrintf("\t\tif err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"yaml.Marshal(%%v): %%v\", want, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tvar got %s\n", typeName)
+		g.testPrintf("\t\tif err := yaml.Unmarshal(data, &got); err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"yaml.Unmarshal(%%q): %%v\", data, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tif got != want {\n")
+		g.testPrintf("\t\t\tt.Errorf(\"YAML round trip of %%v: got %%v\", want, got)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("}\n\n")
+		g.logf("wrote YAML round trip test")
+	}
+
+	if g.doScanValue {
+		g.testPrintf("func Test%sSQLRoundTrip(t *testing.T) {\n", typeName)
+		g.testPrintf("\tfor _, want := range []%s{%s} {\n", typeName, all)
+		g.writeDriverValue("want")
+		g.testPrintf("\t\tvar got %s\n", typeName)
+		g.testPrintf("\t\tif err := got.Scan(value); err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"Scan(%%v): %%v\", value, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tif got != want {\n")
+		g.testPrintf("\t\t\tt.Errorf(\"SQL round trip of %%v: got %%v\", want, got)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("}\n\n")
+		g.logf("wrote SQL round trip test")
+
+		_, convType := g.getReadAssignVarAndConvType(kind)
+		if unknown, ok := g.unknownInput(values, kind); ok {
+			// drivers deliver integer columns as int64
+			switch convType {
+			case "string":
+				unknown = strconv.Quote(unknown)
+			case "int":
+				convType = "int64"
+			case "uint":
+				convType = "uint64"
+			}
+			g.testPrintf("func Test%sSQLUnknown(t *testing.T) {\n", typeName)
+			g.testPrintf("\tvar got %s\n", typeName)
+			g.testPrintf("\terr := got.Scan(%s(%s))\n", convType, unknown)
+			g.writeUnknownAssertion("Scan")
+			g.testPrintf("}\n\n")
+			g.logf("wrote SQL unknown value test")
+		}
+	}
+
+	if g.unset != nil {
+		g.writeUnsetTests(values, typeName)
+	}
+
+	if g.doNull {
+		g.writeNullTests(values, typeName)
+	}
+}
+
+// writeUnsetTests checks that null inputs are read as the constant annotated "// enum:unset".
+func (g *Generator) writeUnsetTests(values []Value, typeName string) {
+	// start from another constant so that the test fails if the reader leaves the value untouched
+	start := g.unset.Name
+	if i := slices.IndexFunc(values, func(v Value) bool { return !v.Unset }); i >= 0 {
+		start = values[i].Name
+	}
+	g.testPrintf("func Test%sNullIsUnset(t *testing.T) {\n", typeName)
+	if g.doJson {
+		g.testPrintf("\tfromJSON := %s\n", start)
+		g.testPrintf("\tif err := json.Unmarshal([]byte(\"null\"), &fromJSON); err != nil {\n")
+		g.testPrintf("\t\tt.Fatalf(\"json.Unmarshal(null): %%v\", err)\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("\tif fromJSON != %s {\n", g.unset.Name)
+		g.testPrintf("\t\tt.Errorf(\"json.Unmarshal(null): got %%v, want %s\", fromJSON)\n", g.unset.Name)
+		g.testPrintf("\t}\n")
+	}
+	if g.doScanValue {
+		g.testPrintf("\tfromSQL := %s\n", start)
+		g.testPrintf("\tif err := fromSQL.Scan(nil); err != nil {\n")
+		g.testPrintf("\t\tt.Fatalf(\"Scan(nil): %%v\", err)\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("\tif fromSQL != %s {\n", g.unset.Name)
+		g.testPrintf("\t\tt.Errorf(\"Scan(nil): got %%v, want %s\", fromSQL)\n", g.unset.Name)
+		g.testPrintf("\t}\n")
+	}
+	g.testPrintf("}\n\n")
+	g.logf("wrote null input test")
+}
+
+// writeNullTests checks that the wrapper written by writeNullWrapper round trips both null and a constant.
+func (g *Generator) writeNullTests(values []Value, typeName string) {
+	nullType := NullTypeName(typeName)
+	g.testPrintf("func Test%sRoundTrip(t *testing.T) {\n", nullType)
+	g.testPrintf("\tfor _, want := range []%s{{}, {%s: %s, Valid: true}} {\n", nullType, typeName, values[len(values)-1].Name)
+	if g.doJson {
+		g.testPrintf("\t\tdata, err := json.Marshal(want)\n")
+		g.testPrintf("\t\tif err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"json.Marshal(%%v): %%v\", want, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tvar fromJSON %s\n", nullType)
+		g.testPrintf("\t\tif err := json.Unmarshal(data, &fromJSON); err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"json.Unmarshal(%%s): %%v\", data, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tif fromJSON != want {\n")
+		g.testPrintf("\t\t\tt.Errorf(\"JSON round trip of %%v: got %%v\", want, fromJSON)\n")
+		g.testPrintf("\t\t}\n")
+	}
+	if g.doScanValue {
+		g.writeDriverValue("want")
+		g.testPrintf("\t\tvar fromSQL %s\n", nullType)
+		g.testPrintf("\t\tif err := fromSQL.Scan(value); err != nil {\n")
+		g.testPrintf("\t\t\tt.Fatalf(\"Scan(%%v): %%v\", value, err)\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t\tif fromSQL != want {\n")
+		g.testPrintf("\t\t\tt.Errorf(\"SQL round trip of %%v: got %%v\", want, fromSQL)\n")
+		g.testPrintf("\t\t}\n")
+	}
+	g.testPrintf("\t}\n")
+	g.testPrintf("}\n\n")
+	g.logf("wrote %s round trip test", nullType)
+
+	// start from a valid value so that the test fails if a rejected input leaves it untouched
+	g.testPrintf("func Test%sInvalidInput(t *testing.T) {\n", nullType)
+	if g.doJson {
+		g.testPrintf("\tfromJSON := %s{%s: %s, Valid: true}\n", nullType, typeName, values[len(values)-1].Name)
+		g.testPrintf("\tif err := fromJSON.UnmarshalJSON([]byte(\"{}\")); err == nil {\n")
+		g.testPrintf("\t\tt.Errorf(\"UnmarshalJSON({}): want an error\")\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("\tif fromJSON != (%s{}) {\n", nullType)
+		g.testPrintf("\t\tt.Errorf(\"UnmarshalJSON({}): got %%v, want null\", fromJSON)\n")
+		g.testPrintf("\t}\n")
+	}
+	if g.doScanValue {
+		g.testPrintf("\tfromSQL := %s{%s: %s, Valid: true}\n", nullType, typeName, values[len(values)-1].Name)
+		g.testPrintf("\tif err := fromSQL.Scan(struct{}{}); err == nil {\n")
+		g.testPrintf("\t\tt.Errorf(\"Scan(struct{}{}): want an error\")\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("\tif fromSQL != (%s{}) {\n", nullType)
+		g.testPrintf("\t\tt.Errorf(\"Scan(struct{}{}): got %%v, want null\", fromSQL)\n")
+		g.testPrintf("\t}\n")
+	}
+	g.testPrintf("}\n\n")
+	g.logf("wrote %s invalid input test", nullType)
+}
+
+// writeDriverValue declares value holding what database/sql hands a driver for the Valuer v:
+// the result of v.Value converted by driver.DefaultParameterConverter, which turns int and uint into int64.
+func (g *Generator) writeDriverValue(v string) {
+	g.addTestImport("database/sql/driver")
+	g.testPrintf("\t\tvalue, err := %s.Value()\n", v)
+	g.testPrintf("\t\tif err != nil {\n")
+	g.testPrintf("\t\t\tt.Fatalf(\"Value(%%v): %%v\", %s, err)\n", v)
+	g.testPrintf("\t\t}\n")
+	g.testPrintf("\t\tif value, err = driver.DefaultParameterConverter.ConvertValue(value); err != nil {\n")
+	g.testPrintf("\t\t\tt.Fatalf(\"ConvertValue(%%v): %%v\", %s, err)\n", v)
+	g.testPrintf("\t\t}\n")
+}
+
+func (g *Generator) writeBenchmarks(values []Value, kind ValueType, typeName string) {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.Name
+	}
+	all := strings.Join(names, ", ")
+
+	if g.doJson {
+		g.writeReadBenchmark(typeName, all, "UnmarshalJSON", "MarshalJSON", "[]byte")
+		g.writeWriteBenchmark(typeName, all, "MarshalJSON")
+	}
+	if g.doScanValue {
+		g.writeReadBenchmark(typeName, all, "Scan", "Value", "driver.Value")
+		g.writeWriteBenchmark(typeName, all, "Value")
+	}
+
+	if (kind == TypeSigned || kind == TypeUnsigned) && g.useString && g.isStringer {
+		g.testPrintf("// Benchmark%sLinearStringLookup measures matching String() against every constant in turn, for comparison with the generated map lookup.\n", typeName)
+		g.testPrintf("func Benchmark%sLinearStringLookup(b *testing.B) {\n", typeName)
+		g.testPrintf("\tvalues := []%s{%s}\n", typeName, all)
+		g.testPrintf("\tinputs := make([]string, len(values))\n")
+		g.testPrintf("\tfor i, v := range values {\n")
+		g.testPrintf("\t\tinputs[i] = v.String()\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("\tb.ReportAllocs()\n")
+		g.testPrintf("\tb.ResetTimer()\n")
+		g.testPrintf("\tvar got %s\n", typeName)
+		g.testPrintf("\tfor i := 0; i < b.N; i++ {\n")
+		g.testPrintf("\t\tstr := inputs[i%%len(inputs)]\n")
+		g.testPrintf("\t\tfor _, v := range values {\n")
+		g.testPrintf("\t\t\tif v.String() == str {\n")
+		g.testPrintf("\t\t\t\tgot = v\n")
+		g.testPrintf("\t\t\t\tbreak\n")
+		g.testPrintf("\t\t\t}\n")
+		g.testPrintf("\t\t}\n")
+		g.testPrintf("\t}\n")
+		g.testPrintf("\t_ = got\n")
+		g.testPrintf("}\n\n")
+		g.logf("wrote linear String() lookup benchmark")
+	}
+}
+
+// writeReadBenchmark benchmarks method by feeding it the output of writer for every constant.
+func (g *Generator) writeReadBenchmark(typeName string, all string, method string, writer string, inputType string) {
+	if inputType == "driver.Value" {
+		// avoid importing database/sql/driver into the test file
+		inputType = "interface{}"
+	}
+	g.testPrintf("func Benchmark%s%s(b *testing.B) {\n", typeName, method)
+	g.testPrintf("\tvar inputs []%s\n", inputType)
+	g.testPrintf("\tfor _, v := range []%s{%s} {\n", typeName, all)
+	g.testPrintf("\t\tinput, err := v.%s()\n", writer)
+	g.testPrintf("\t\tif err != nil {\n")
+	g.testPrintf("\t\t\tb.Fatal(err)\n")
+	g.testPrintf("\t\t}\n")
+	g.testPrintf("\t\tinputs = append(inputs, input)\n")
+	g.testPrintf("\t}\n")
+	g.testPrintf("\tb.ReportAllocs()\n")
+	g.testPrintf("\tb.ResetTimer()\n")
+	g.testPrintf("\tvar got %s\n", typeName)
+	g.testPrintf("\tfor i := 0; i < b.N; i++ {\n")
+	g.testPrintf("\t\tif err := got.%s(inputs[i%%len(inputs)]); err != nil {\n", method)
+	g.testPrintf("\t\t\tb.Fatal(err)\n")
+	g.testPrintf("\t\t}\n")
+	g.testPrintf("\t}\n")
+	g.testPrintf("}\n\n")
+	g.logf("wrote %s benchmark", method)
+}
+
+func (g *Generator) writeWriteBenchmark(typeName string, all string, method string) {
+	g.testPrintf("func Benchmark%s%s(b *testing.B) {\n", typeName, method)
+	g.testPrintf("\tvalues := []%s{%s}\n", typeName, all)
+	g.testPrintf("\tb.ReportAllocs()\n")
+	g.testPrintf("\tb.ResetTimer()\n")
+	g.testPrintf("\tfor i := 0; i < b.N; i++ {\n")
+	g.testPrintf("\t\tif _, err := values[i%%len(values)].%s(); err != nil {\n", method)
+	g.testPrintf("\t\t\tb.Fatal(err)\n")
+	g.testPrintf("\t\t}\n")
+	g.testPrintf("\t}\n")
+	g.testPrintf("}\n\n")
+	g.logf("wrote %s benchmark", method)
+}
+
+// writeUnknownAssertion checks err and got against the unknown value policy of writeReadDefaultCase.
+func (g *Generator) writeUnknownAssertion(method string) {
+	switch {
+	case g.fallsBack():
+		g.testPrintf("\tif err != nil {\n")
+		g.testPrintf("\t\tt.Fatalf(\"%s of an unknown value: %%v\", err)\n", method)
+		g.testPrintf("\t}\n")
+		g.testPrintf("\tif got != %s {\n", g.defaultValue.Name)
+		g.testPrintf("\t\tt.Errorf(\"%s of an unknown value: got %%v, want %s\", got)\n", method, g.defaultValue.Name)
+		g.testPrintf("\t}\n")
+	default:
+		g.testPrintf("\tif err == nil {\n")
+		g.testPrintf("\t\tt.Errorf(\"%s of an unknown value: got %%v, want an error\", got)\n", method)
+		g.testPrintf("\t}\n")
+	}
+}
+
+// unknownInput returns the textual form of an input that matches none of values,
+// or false if values cover every input in the range of the kind, as for a bool type declaring both true and false.
+func (g *Generator) unknownInput(values []Value, kind ValueType) (string, bool) {
+	switch {
+	case kind == TypeRune:
+		for r := 'a'; ; r++ {
+			if !slices.ContainsFunc(values, func(v Value) bool { return v.StrVal == strconv.Itoa(int(r)) }) {
+				return string(r), true
+			}
+		}
+	case kind == TypeBool:
+		for _, b := range []string{"true", "false"} {
+			if !slices.ContainsFunc(values, func(v Value) bool { return v.StrVal == b }) {
+				return b, true
+			}
+		}
+		return "", false
+	case kind == TypeFloat:
+		highest := math.Inf(-1)
+		for _, v := range values {
+			if lit, err := v.Literal(); err == nil {
+				highest = max(highest, lit.(float64))
+			}
+		}
+		return strconv.FormatFloat(math.Floor(highest)+1, 'f', -1, 64), true
+	case kind == TypeString, g.useString && g.isStringer:
+		unknown := "go-enum-codegen unknown value"
+		for slices.ContainsFunc(values, func(v Value) bool { return v.StrVal == strconv.Quote(unknown) }) {
+			unknown += "_"
+		}
+		return unknown, true
+	case kind == TypeSigned:
+		// int and int64 have no narrower range
+		upper := int64(math.MaxInt64) >> (64 - cmp.Or(BitSize(g.basicKind), 64))
+		lower := -upper - 1
+		used := make(map[int64]bool, len(values))
+		highest := lower
+		for _, v := range values {
+			if lit, err := v.Literal(); err == nil {
+				used[lit.(int64)] = true
+				highest = max(highest, lit.(int64))
+			}
+		}
+		if highest < upper {
+			return strconv.FormatInt(highest+1, 10), true
+		}
+		for n := lower; ; n++ {
+			if !used[n] {
+				return strconv.FormatInt(n, 10), true
+			}
+			if n == upper {
+				return "", false
+			}
+		}
+	default:
+		upper := uint64(math.MaxUint64) >> (64 - cmp.Or(BitSize(g.basicKind), 64))
+		used := make(map[uint64]bool, len(values))
+		var highest uint64
+		for _, v := range values {
+			if lit, err := v.Literal(); err == nil {
+				used[lit.(uint64)] = true
+				highest = max(highest, lit.(uint64))
+			}
+		}
+		if highest < upper {
+			return strconv.FormatUint(highest+1, 10), true
+		}
+		for n := uint64(0); ; n++ {
+			if !used[n] {
+				return strconv.FormatUint(n, 10), true
+			}
+			if n == upper {
+				return "", false
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package goenumcodegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// TypeScript returns a TypeScript module declaring typeName as a union of its serialized values
+// and a const object keyed by constant name, optionally with an is<Type> type guard.
+// Generate must have been called for typeName first.
+func (g *Generator) TypeScript(typeName string, guards bool) ([]byte, error) {
+	e, err := g.enum(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	wire, err := g.wireValues(e)
+	if err != nil {
+		return nil, err
+	}
+
+	literals := make([]string, len(wire))
+	for i, w := range wire {
+		lit, err := json.Marshal(w)
+		if err != nil {
+			return nil, err
+		}
+		literals[i] = string(lit)
+	}
+
+	var s strings.Builder
+	_, _ = s.WriteString(fmt.Sprintf("// Code generated by go-enum-codegen for %s; DO NOT EDIT.\n\n", typeName))
+	_, _ = s.WriteString(fmt.Sprintf("export type %s = %s;\n\n", typeName, strings.Join(literals, " | ")))
+	_, _ = s.WriteString(fmt.Sprintf("export const %s = {\n", typeName))
+	for i, v := range e.Values {
+		if v.Doc != "" {
+			_, _ = s.WriteString(fmt.Sprintf("  /** %s */\n", strings.NewReplacer("\n", " ", "*/", "* /").Replace(v.Doc)))
+		}
+		_, _ = s.WriteString(fmt.Sprintf("  %s: %s,\n", tsKey(typeName, v.Name), literals[i]))
+	}
+	_, _ = s.WriteString("} as const;\n")
+	if guards {
+		_, _ = s.WriteString(fmt.Sprintf("\nexport function is%s(value: unknown): value is %s {\n", typeName, typeName))
+		_, _ = s.WriteString(fmt.Sprintf("  return (Object.values(%s) as unknown[]).includes(value);\n", typeName))
+		_, _ = s.WriteString("}\n")
+	}
+
+	return []byte(s.String()), nil
+}
+
+// tsKey strips the type name prefix from a constant name when what remains is still an identifier.
+func tsKey(typeName string, name string) string {
+	key := strings.TrimPrefix(name, typeName)
+	if key == "" || !unicode.IsLetter([]rune(key)[0]) {
+		return name
+	}
+	return key
+}
@@ -1,17 +1,139 @@
 package goenumcodegen
 
+import (
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"strconv"
+)
+
 type ValueType string
 
 const (
 	TypeString   ValueType = "string"
 	TypeSigned   ValueType = "int"
 	TypeUnsigned ValueType = "uint"
+	TypeRune     ValueType = "rune"
+	TypeBool     ValueType = "bool"
+	TypeFloat    ValueType = "float"
 )
 
+// zero returns the Go literal of the zero value of kind, in the form of Value.StrVal.
+func (t ValueType) zero() string {
+	switch t {
+	case TypeString:
+		return `""`
+	case TypeBool:
+		return "false"
+	default:
+		return "0"
+	}
+}
+
 type Value struct {
-	Name       string
-	ValType    ValueType
-	StrVal     string
-	IsStringer bool
-	RecvName   string
+	Name       string    `json:"name"`
+	ValType    ValueType `json:"kind"`
+	StrVal     string    `json:"literal"`
+	IsStringer bool      `json:"isStringer"`
+	RecvName   string    `json:"receiver"`
+	Doc        string    `json:"doc,omitempty"`
+	// BasicKind is the exact underlying kind, e.g. types.Uint8
+	BasicKind types.BasicKind `json:"-"`
+	// Unset is true if the constant is annotated "// enum:unset"
+	Unset bool `json:"unset,omitempty"`
+	// Default is true if the constant is annotated "// enum:default"
+	Default bool `json:"default,omitempty"`
+	// Position is where the constant is declared
+	Position Position `json:"position"`
+}
+
+// MarshalJSON encodes v with StrVal as "literal", the Go source form of the constant,
+// and the value returned by Literal as "value".
+func (v Value) MarshalJSON() ([]byte, error) {
+	// value has the fields of Value but not its methods, so encoding it does not recurse
+	type value Value
+	lit, err := v.Literal()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		value
+		Value any `json:"value"`
+	}{value(v), lit})
+}
+
+// Position is the location of a declaration in a source file.
+type Position struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+// Literal returns the Go value of the constant: a string for TypeString and TypeRune,
+// an int64 for TypeSigned, a uint64 for TypeUnsigned, a bool for TypeBool and a float64 for TypeFloat.
+func (v Value) Literal() (any, error) {
+	switch v.ValType {
+	case TypeString:
+		return strconv.Unquote(v.StrVal)
+	case TypeSigned:
+		return strconv.ParseInt(v.StrVal, 10, 64)
+	case TypeUnsigned:
+		return strconv.ParseUint(v.StrVal, 10, 64)
+	case TypeRune:
+		r, err := strconv.ParseInt(v.StrVal, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return string(rune(r)), nil
+	case TypeBool:
+		return strconv.ParseBool(v.StrVal)
+	case TypeFloat:
+		return strconv.ParseFloat(v.StrVal, 64)
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", v.ValType)
+	}
+}
+
+// Enum is the model of a single enum type collected by Generator.Generate.
+// Its JSON encoding is printed by go-enum-codegen -dump-model.
+type Enum struct {
+	TypeName   string    `json:"type"`
+	Kind       ValueType `json:"kind"`
+	RecvName   string    `json:"receiver"`
+	IsStringer bool      `json:"isStringer"`
+	// DefaultValue is the constant readers assign to unknown values, or nil if they return an error instead
+	DefaultValue *Value `json:"default"`
+	// Unset is the constant annotated "// enum:unset", if any
+	Unset *Value `json:"unset,omitempty"`
+	// Values holds every constant of the type in declaration order.
+	Values []Value `json:"values"`
+}
+
+// BitSize returns the size in bits of a sized integer or float kind, or 0 for int, uint, and uintptr
+// whose size depends on the platform.
+func BitSize(kind types.BasicKind) int {
+	switch kind {
+	case types.Int8, types.Uint8:
+		return 8
+	case types.Int16, types.Uint16:
+		return 16
+	case types.Int32, types.Uint32, types.Float32:
+		return 32
+	case types.Int64, types.Uint64, types.Float64:
+		return 64
+	default:
+		return 0
+	}
+}
+
+// rangeBounds returns the names of the math constants bounding a sized integer kind narrower than 64 bits.
+func rangeBounds(kind types.BasicKind) (string, string, bool) {
+	switch kind {
+	case types.Int8, types.Int16, types.Int32:
+		return fmt.Sprintf("math.MinInt%d", BitSize(kind)), fmt.Sprintf("math.MaxInt%d", BitSize(kind)), true
+	case types.Uint8, types.Uint16, types.Uint32:
+		return "", fmt.Sprintf("math.MaxUint%d", BitSize(kind)), true
+	default:
+		return "", "", false
+	}
 }
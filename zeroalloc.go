@@ -0,0 +1,31 @@
+package goenumcodegen
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// JSONTableName returns the name of the package-level variable holding the MarshalJSON output of each constant of typeName.
+func JSONTableName(typeName string) string {
+	return fmt.Sprintf("_%s_json", typeName)
+}
+
+// jsonQuoted reports whether MarshalJSON writes values of kind as JSON strings rather than bare literals.
+func (g *Generator) jsonQuoted(kind ValueType) bool {
+	return g.useString && g.isStringer || kind == TypeString || kind == TypeRune
+}
+
+// jsonLiteral returns the bytes MarshalJSON writes for a non-stringer value, as a JSON string if quoted.
+func jsonLiteral(v Value, quoted bool) string {
+	lit, err := v.Literal()
+	if err != nil {
+		return v.StrVal
+	}
+	if f, ok := lit.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, BitSize(v.BasicKind))
+	}
+	if quoted {
+		return strconv.Quote(fmt.Sprint(lit))
+	}
+	return fmt.Sprint(lit)
+}